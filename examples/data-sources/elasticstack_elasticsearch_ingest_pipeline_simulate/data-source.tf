@@ -0,0 +1,25 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_grok" "access_log" {
+  field    = "message"
+  patterns = ["%%{IP:client} %%{WORD:method} %%{URIPATHPARAM:request}"]
+}
+
+data "elasticstack_elasticsearch_ingest_pipeline_simulate" "access_log" {
+  processors = [
+    data.elasticstack_elasticsearch_ingest_processor_grok.access_log.json
+  ]
+  verbose = true
+
+  docs = [
+    jsonencode({
+      _source = { message = "55.3.244.1 GET /index.html" }
+    })
+  ]
+}
+
+output "parsed_access_log" {
+  value = jsondecode(data.elasticstack_elasticsearch_ingest_pipeline_simulate.access_log.documents[0].source)
+}
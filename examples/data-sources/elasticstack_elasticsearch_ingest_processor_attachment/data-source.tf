@@ -0,0 +1,16 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_attachment" "attachment" {
+  field         = "data"
+  remove_binary = true
+}
+
+resource "elasticstack_elasticsearch_ingest_pipeline" "my_ingest_pipeline" {
+  name = "attachment-ingest"
+
+  processors = [
+    data.elasticstack_elasticsearch_ingest_processor_attachment.attachment.json
+  ]
+}
@@ -0,0 +1,17 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_geo_grid" "grid" {
+  field        = "geotile"
+  tile_type    = "geotile"
+  target_field = "grid"
+}
+
+resource "elasticstack_elasticsearch_ingest_pipeline" "my_ingest_pipeline" {
+  name = "geo-grid-ingest"
+
+  processors = [
+    data.elasticstack_elasticsearch_ingest_processor_geo_grid.grid.json
+  ]
+}
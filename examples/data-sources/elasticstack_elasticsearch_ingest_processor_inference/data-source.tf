@@ -0,0 +1,20 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_inference" "inference" {
+  model_id = "lang_ident_model_1"
+
+  input_output {
+    input_field  = "content"
+    output_field = "content_language"
+  }
+}
+
+resource "elasticstack_elasticsearch_ingest_pipeline" "my_ingest_pipeline" {
+  name = "inference-ingest"
+
+  processors = [
+    data.elasticstack_elasticsearch_ingest_processor_inference.inference.json
+  ]
+}
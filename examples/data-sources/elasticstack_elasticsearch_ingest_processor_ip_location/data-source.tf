@@ -0,0 +1,15 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_ip_location" "location" {
+  field = "ip"
+}
+
+resource "elasticstack_elasticsearch_ingest_pipeline" "my_ingest_pipeline" {
+  name = "ip-location-ingest"
+
+  processors = [
+    data.elasticstack_elasticsearch_ingest_processor_ip_location.location.json
+  ]
+}
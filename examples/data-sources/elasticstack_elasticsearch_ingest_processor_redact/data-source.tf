@@ -0,0 +1,16 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_redact" "redact" {
+  field    = "message"
+  patterns = ["%%{IP:client}", "%%{EMAILADDRESS:email}"]
+}
+
+resource "elasticstack_elasticsearch_ingest_pipeline" "my_ingest_pipeline" {
+  name = "redact-ingest"
+
+  processors = [
+    data.elasticstack_elasticsearch_ingest_processor_redact.redact.json
+  ]
+}
@@ -0,0 +1,15 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_terminate" "terminate" {
+  if = "ctx.error != null"
+}
+
+resource "elasticstack_elasticsearch_ingest_pipeline" "my_ingest_pipeline" {
+  name = "terminate-ingest"
+
+  processors = [
+    data.elasticstack_elasticsearch_ingest_processor_terminate.terminate.json
+  ]
+}
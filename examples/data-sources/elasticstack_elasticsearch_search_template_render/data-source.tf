@@ -0,0 +1,26 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_script" "my_search_template" {
+  script_id = "my_search_template"
+  lang      = "mustache"
+  source = jsonencode({
+    query = {
+      match = {
+        message = "{{query_string}}"
+      }
+    }
+  })
+}
+
+data "elasticstack_elasticsearch_search_template_render" "my_query" {
+  template_id = elasticstack_elasticsearch_script.my_search_template.script_id
+  params = jsonencode({
+    query_string = "hello world"
+  })
+}
+
+output "rendered_query" {
+  value = jsondecode(data.elasticstack_elasticsearch_search_template_render.my_query.rendered)
+}
@@ -0,0 +1,13 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_authenticate" "me" {}
+
+output "username" {
+  value = data.elasticstack_elasticsearch_security_authenticate.me.username
+}
+
+output "roles" {
+  value = data.elasticstack_elasticsearch_security_authenticate.me.roles
+}
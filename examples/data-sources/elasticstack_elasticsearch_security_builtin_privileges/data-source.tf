@@ -0,0 +1,13 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_builtin_privileges" "builtin" {}
+
+output "cluster_privileges" {
+  value = data.elasticstack_elasticsearch_security_builtin_privileges.builtin.cluster
+}
+
+output "index_privileges" {
+  value = data.elasticstack_elasticsearch_security_builtin_privileges.builtin.index
+}
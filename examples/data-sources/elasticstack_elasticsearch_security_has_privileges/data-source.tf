@@ -0,0 +1,20 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_has_privileges" "check" {
+  cluster = ["monitor", "manage_ilm"]
+
+  index {
+    names      = ["logs-*"]
+    privileges = ["read", "write"]
+  }
+}
+
+output "has_all_requested" {
+  value = data.elasticstack_elasticsearch_security_has_privileges.check.has_all_requested
+}
+
+output "cluster_results" {
+  value = data.elasticstack_elasticsearch_security_has_privileges.check.cluster_results
+}
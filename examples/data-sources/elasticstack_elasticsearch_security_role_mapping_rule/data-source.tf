@@ -0,0 +1,42 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_rule" "admin_groups" {
+  field {
+    name   = "groups"
+    values = ["cn=admins,dc=example,dc=com", "cn=ops,dc=example,dc=com"]
+  }
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_rule" "ldap_realm" {
+  field {
+    name   = "realm.name"
+    values = ["ldap1"]
+  }
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_rule" "disabled_users" {
+  field {
+    name   = "metadata.disabled"
+    values = ["true"]
+  }
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_rule" "not_disabled" {
+  except = data.elasticstack_elasticsearch_security_role_mapping_rule.disabled_users.json
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_rule" "ldap_admins" {
+  all = [
+    data.elasticstack_elasticsearch_security_role_mapping_rule.admin_groups.json,
+    data.elasticstack_elasticsearch_security_role_mapping_rule.ldap_realm.json,
+    data.elasticstack_elasticsearch_security_role_mapping_rule.not_disabled.json,
+  ]
+}
+
+resource "elasticstack_elasticsearch_security_role_mapping" "ldap_admins" {
+  name  = "ldap_admins"
+  roles = ["superuser"]
+  rules = data.elasticstack_elasticsearch_security_role_mapping_rule.ldap_admins.json
+}
@@ -0,0 +1,9 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_slm_status" "status" {}
+
+output "slm_operation_mode" {
+  value = data.elasticstack_elasticsearch_slm_status.status.operation_mode
+}
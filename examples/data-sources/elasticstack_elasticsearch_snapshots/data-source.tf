@@ -0,0 +1,15 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_snapshots" "latest" {
+  repository = "my_repository"
+  name       = "nightly-*"
+  sort       = "start_time"
+  order      = "desc"
+  size       = 1
+}
+
+output "latest_snapshot" {
+  value = data.elasticstack_elasticsearch_snapshots.latest.snapshots[0].name
+}
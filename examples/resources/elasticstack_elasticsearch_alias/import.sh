@@ -0,0 +1,2 @@
+terraform import elasticstack_elasticsearch_alias.my_alias <cluster_uuid>/<alias_name>
+
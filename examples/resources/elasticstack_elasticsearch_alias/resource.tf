@@ -0,0 +1,28 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "products_v3" {
+  name = "products-v3"
+}
+
+resource "elasticstack_elasticsearch_index" "products_v4" {
+  name = "products-v4"
+}
+
+resource "elasticstack_elasticsearch_alias" "products" {
+  name = "products"
+
+  index {
+    name = elasticstack_elasticsearch_index.products_v3.name
+    filter = jsonencode({
+      term = { "status" = "published" }
+    })
+  }
+
+  # moving `is_write_index` between indices is applied in a single request
+  index {
+    name           = elasticstack_elasticsearch_index.products_v4.name
+    is_write_index = true
+  }
+}
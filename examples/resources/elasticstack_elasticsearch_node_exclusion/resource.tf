@@ -0,0 +1,15 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+# Move the shards off the nodes before their maintenance
+resource "elasticstack_elasticsearch_node_exclusion" "maintenance" {
+  names = ["es-data-3", "es-data-4"]
+  attributes = {
+    rack = "rack2"
+  }
+
+  timeouts {
+    create = "2h"
+  }
+}
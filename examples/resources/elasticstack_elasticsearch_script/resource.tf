@@ -25,3 +25,18 @@ resource "elasticstack_elasticsearch_script" "my_search_template" {
     query_string = "My query string"
   })
 }
+
+resource "elasticstack_elasticsearch_script" "my_validated_script" {
+  script_id = "my_validated_script"
+  lang      = "painless"
+  source    = "params.count / params.total"
+
+  # compile and run the script at the plan time
+  validation {
+    params = jsonencode({
+      count = 100.0
+      total = 1000.0
+    })
+    expected_result = "0.1"
+  }
+}
@@ -0,0 +1,20 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+variable "service_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "elasticstack_elasticsearch_security_api_key" "service" {
+  name = "service-api-key"
+
+  grant {
+    grant_type = "password"
+    username   = "service-user"
+    password   = var.service_password
+  }
+
+  expiration = "30d"
+}
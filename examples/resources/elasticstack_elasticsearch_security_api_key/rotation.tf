@@ -0,0 +1,9 @@
+resource "elasticstack_elasticsearch_security_api_key" "rotated" {
+  name = "My rotated API key"
+
+  # Create a new key every 30 days, the previous key stays valid for 2 more days
+  rotation {
+    rotate_after      = "720h"
+    keep_previous_for = "48h"
+  }
+}
@@ -0,0 +1,30 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_cross_cluster_api_key" "cross_cluster_key" {
+  name = "My cross-cluster API key"
+
+  access {
+    search {
+      names = ["logs-*"]
+      field_security {
+        grant = ["*"]
+      }
+    }
+    replication {
+      names = ["archive-*"]
+    }
+  }
+
+  expiration = "30d"
+
+  metadata = jsonencode({
+    "env" = "production"
+  })
+}
+
+output "cross_cluster_api_key" {
+  value     = elasticstack_elasticsearch_security_cross_cluster_api_key.cross_cluster_key.encoded
+  sensitive = true
+}
@@ -0,0 +1 @@
+terraform import elasticstack_elasticsearch_security_privilege.my_privilege <cluster_uuid>/<application>:<privilege name>
@@ -0,0 +1,14 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_service_account_token" "fleet_server" {
+  namespace = "elastic"
+  service   = "fleet-server"
+  name      = "fleet-server-token"
+}
+
+output "fleet_server_token" {
+  value     = elasticstack_elasticsearch_security_service_account_token.fleet_server.value
+  sensitive = true
+}
@@ -36,3 +36,21 @@ resource "elasticstack_elasticsearch_security_user" "dev" {
     "number" = 49
   })
 }
+
+resource "elasticstack_elasticsearch_security_user" "app" {
+  username = "appuser"
+
+  // the password is never stored in the state, bump the version to rotate it
+  password_wo      = var.app_user_password
+  password_version = "1"
+
+  // hash the password locally, only the hash is sent to Elasticsearch
+  password_hashing_algorithm = "bcrypt"
+
+  roles = ["kibana_user"]
+}
+
+variable "app_user_password" {
+  type      = string
+  sensitive = true
+}
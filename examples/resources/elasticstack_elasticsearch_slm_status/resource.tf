@@ -0,0 +1,8 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+# Pause the snapshot lifecycle management during the maintenance window
+resource "elasticstack_elasticsearch_slm_status" "maintenance" {
+  running = false
+}
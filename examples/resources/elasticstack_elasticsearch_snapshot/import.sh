@@ -0,0 +1 @@
+terraform import elasticstack_elasticsearch_snapshot.my_snapshot <cluster_uuid>/<repository name>:<snapshot name>
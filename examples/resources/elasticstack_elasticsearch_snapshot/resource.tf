@@ -0,0 +1,25 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "repo" {
+  name = "my_repository"
+
+  fs {
+    location = "/tmp/snapshots"
+  }
+}
+
+resource "elasticstack_elasticsearch_snapshot" "before_migration" {
+  repository = elasticstack_elasticsearch_snapshot_repository.repo.name
+  name       = "before-mapping-migration"
+
+  indices              = ["logs-*"]
+  include_global_state = false
+  metadata = jsonencode({
+    taken_by = "terraform"
+    reason   = "mapping migration"
+  })
+
+  delete_on_destroy = true
+}
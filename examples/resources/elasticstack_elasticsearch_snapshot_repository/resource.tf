@@ -19,3 +19,18 @@ resource "elasticstack_elasticsearch_snapshot_repository" "my_fs_repo" {
     max_restore_bytes_per_sec = "10mb"
   }
 }
+
+resource "elasticstack_elasticsearch_snapshot_repository" "my_source_repo" {
+  name = "my_source_repo"
+
+  source {
+    fs {
+      location = "/tmp/source"
+    }
+  }
+
+  analyze {
+    blob_count    = 10
+    max_blob_size = "1mb"
+  }
+}
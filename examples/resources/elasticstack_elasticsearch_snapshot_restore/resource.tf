@@ -0,0 +1,23 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_snapshots" "latest" {
+  repository = "production"
+  sort       = "start_time"
+  order      = "desc"
+  size       = 1
+}
+
+resource "elasticstack_elasticsearch_snapshot_restore" "staging" {
+  repository = data.elasticstack_elasticsearch_snapshots.latest.repository
+  snapshot   = data.elasticstack_elasticsearch_snapshots.latest.snapshots[0].name
+
+  indices            = ["logs-*"]
+  include_aliases    = false
+  rename_pattern     = "(.+)"
+  rename_replacement = "restored-$1"
+  index_settings = jsonencode({
+    "index.number_of_replicas" = 0
+  })
+}
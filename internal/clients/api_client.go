@@ -93,7 +93,21 @@ func NewAcceptanceTestingClient() (*ApiClient, error) {
 
 const esConnectionKey string = "elasticsearch_connection"
 
+// resourceConfig is implemented by both schema.ResourceData and schema.ResourceDiff
+type resourceConfig interface {
+	GetOk(string) (interface{}, bool)
+}
+
 func NewApiClient(d *schema.ResourceData, meta interface{}) (*ApiClient, diag.Diagnostics) {
+	return newApiClient(d, meta)
+}
+
+// NewApiClientFromDiff returns the client to be used in CustomizeDiff functions
+func NewApiClientFromDiff(d *schema.ResourceDiff, meta interface{}) (*ApiClient, diag.Diagnostics) {
+	return newApiClient(d, meta)
+}
+
+func newApiClient(d resourceConfig, meta interface{}) (*ApiClient, diag.Diagnostics) {
 	defaultClient := meta.(*ApiClient)
 
 	if _, ok := d.GetOk(esConnectionKey); ok {
@@ -180,7 +194,7 @@ func (a *ApiClient) ClusterID(ctx context.Context) (*string, diag.Diagnostics) {
 	return nil, diags
 }
 
-func newEsApiClient(d *schema.ResourceData, key string, version string, useEnvAsDefault bool) (*ApiClient, diag.Diagnostics) {
+func newEsApiClient(d resourceConfig, key string, version string, useEnvAsDefault bool) (*ApiClient, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	config := elasticsearch.Config{}
 	config.Header = http.Header{"User-Agent": []string{fmt.Sprintf("elasticstack-terraform-provider/%s", version)}}
@@ -66,6 +66,7 @@ func prettyPrintJsonLines(b []byte) string {
 	parts := strings.Split(string(b), "\n")
 	for i, p := range parts {
 		if b := []byte(p); json.Valid(b) {
+			b = maskSensitiveJsonFields(b)
 			var out bytes.Buffer
 			if err := json.Indent(&out, b, "", " "); err != nil {
 				continue
@@ -84,3 +85,53 @@ func prettyPrintJsonLines(b []byte) string {
 	}
 	return strings.Join(parts, "\n")
 }
+
+// sensitiveJsonFields lists the request and response fields whose string values are masked in the logs
+var sensitiveJsonFields = map[string]bool{
+	"password":     true,
+	"access_token": true,
+	"api_key":      true,
+	"encoded":      true,
+}
+
+// sensitiveNestedJsonFields lists the fields masked only inside the given object, e.g. the secret of the created service account token
+var sensitiveNestedJsonFields = map[string]map[string]bool{
+	"token": {"value": true},
+}
+
+// maskSensitiveJsonFields replaces the string values of the sensitive fields with asterisks,
+// the json is returned unchanged when there is nothing to mask
+func maskSensitiveJsonFields(b []byte) []byte {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return b
+	}
+	if !maskSensitiveValues(v, "") {
+		return b
+	}
+	masked, err := json.Marshal(v)
+	if err != nil {
+		return b
+	}
+	return masked
+}
+
+func maskSensitiveValues(v interface{}, parent string) bool {
+	masked := false
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			if s, ok := val.(string); ok && (sensitiveJsonFields[k] || sensitiveNestedJsonFields[parent][k]) {
+				t[k] = strings.Repeat("*", len(s))
+				masked = true
+				continue
+			}
+			masked = maskSensitiveValues(val, k) || masked
+		}
+	case []interface{}:
+		for _, val := range t {
+			masked = maskSensitiveValues(val, parent) || masked
+		}
+	}
+	return masked
+}
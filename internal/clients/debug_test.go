@@ -0,0 +1,56 @@
+package clients
+
+import (
+	"testing"
+)
+
+func Test_maskSensitiveJsonFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "no sensitive fields",
+			input:    `{"name":"key","role_descriptors":{}}`,
+			expected: `{"name":"key","role_descriptors":{}}`,
+		},
+		{
+			name:     "password grant",
+			input:    `{"grant_type":"password","username":"user","password":"secret","api_key":{"name":"key"}}`,
+			expected: `{"api_key":{"name":"key"},"grant_type":"password","password":"******","username":"user"}`,
+		},
+		{
+			name:     "access token grant",
+			input:    `{"grant_type":"access_token","access_token":"token"}`,
+			expected: `{"access_token":"*****","grant_type":"access_token"}`,
+		},
+		{
+			name:     "api key response",
+			input:    `{"id":"id","name":"key","api_key":"abc","encoded":"abcd"}`,
+			expected: `{"api_key":"***","encoded":"****","id":"id","name":"key"}`,
+		},
+		{
+			name:     "service account token response",
+			input:    `{"created":true,"token":{"name":"token1","value":"secret"}}`,
+			expected: `{"created":true,"token":{"name":"token1","value":"******"}}`,
+		},
+		{
+			name:     "value outside of token",
+			input:    `{"setting":{"value":"1"}}`,
+			expected: `{"setting":{"value":"1"}}`,
+		},
+		{
+			name:     "nested in array",
+			input:    `[{"password":"pw"}]`,
+			expected: `[{"password":"**"}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(maskSensitiveJsonFields([]byte(tt.input))); got != tt.expected {
+				t.Errorf("maskSensitiveJsonFields() = %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 
+	"github.com/elastic/go-elasticsearch/v7/esapi"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
@@ -19,7 +21,13 @@ func PutSnapshotRepository(ctx context.Context, apiClient *clients.ApiClient, re
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	res, err := apiClient.GetESClient().Snapshot.CreateRepository(repository.Name, bytes.NewReader(snapRepoBytes), apiClient.GetESClient().Snapshot.CreateRepository.WithContext(ctx))
+	res, err := apiClient.GetESClient().Snapshot.CreateRepository(
+		repository.Name,
+		bytes.NewReader(snapRepoBytes),
+		// the callers verify the repository with VerifySnapshotRepository, which returns the verified nodes
+		apiClient.GetESClient().Snapshot.CreateRepository.WithVerify(false),
+		apiClient.GetESClient().Snapshot.CreateRepository.WithContext(ctx),
+	)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -78,6 +86,65 @@ func DeleteSnapshotRepository(ctx context.Context, apiClient *clients.ApiClient,
 	return diags
 }
 
+// VerifySnapshotRepository verifies the repository is functional and returns the nodes, on which the repository was verified
+func VerifySnapshotRepository(ctx context.Context, apiClient *clients.ApiClient, name string) ([]models.SnapshotRepositoryNode, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().Snapshot.VerifyRepository(name, apiClient.GetESClient().Snapshot.VerifyRepository.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to verify snapshot repository: %s", name)); diags.HasError() {
+		return nil, diags
+	}
+	var verifyResponse struct {
+		Nodes map[string]models.SnapshotRepositoryNode `json:"nodes"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&verifyResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	nodes := make([]models.SnapshotRepositoryNode, 0, len(verifyResponse.Nodes))
+	for id, node := range verifyResponse.Nodes {
+		node.Id = id
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Id < nodes[j].Id })
+	return nodes, nil
+}
+
+// AnalyzeSnapshotRepository runs the analysis of the repository storage, the request fails if any issue is detected
+func AnalyzeSnapshotRepository(ctx context.Context, apiClient *clients.ApiClient, name string, analysis *models.SnapshotRepositoryAnalysis) (*models.SnapshotRepositoryAnalysisResult, diag.Diagnostics) {
+	esClient := apiClient.GetESClient()
+	opts := []func(*esapi.SnapshotRepositoryAnalyzeRequest){
+		esClient.Snapshot.RepositoryAnalyze.WithHuman(),
+		esClient.Snapshot.RepositoryAnalyze.WithContext(ctx),
+	}
+	if analysis.BlobCount > 0 {
+		opts = append(opts, esClient.Snapshot.RepositoryAnalyze.WithBlobCount(analysis.BlobCount))
+	}
+	if analysis.MaxBlobSize != "" {
+		opts = append(opts, esClient.Snapshot.RepositoryAnalyze.WithMaxBlobSize(analysis.MaxBlobSize))
+	}
+	if analysis.MaxTotalDataSize != "" {
+		opts = append(opts, esClient.Snapshot.RepositoryAnalyze.WithMaxTotalDataSize(analysis.MaxTotalDataSize))
+	}
+	if analysis.Timeout > 0 {
+		opts = append(opts, esClient.Snapshot.RepositoryAnalyze.WithTimeout(analysis.Timeout))
+	}
+	res, err := esClient.Snapshot.RepositoryAnalyze(name, opts...)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Analysis of snapshot repository %s failed", name)); diags.HasError() {
+		return nil, diags
+	}
+	var result models.SnapshotRepositoryAnalysisResult
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &result, nil
+}
+
 func PutSlm(ctx context.Context, apiClient *clients.ApiClient, slm *models.SnapshotPolicy) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -98,7 +165,7 @@ func PutSlm(ctx context.Context, apiClient *clients.ApiClient, slm *models.Snaps
 	return diags
 }
 
-func GetSlm(ctx context.Context, apiClient *clients.ApiClient, slmName string) (*models.SnapshotPolicy, diag.Diagnostics) {
+func GetSlm(ctx context.Context, apiClient *clients.ApiClient, slmName string) (*models.SnapshotPolicyInfo, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	req := apiClient.GetESClient().SlmGetLifecycle.WithPolicyID(slmName)
 	res, err := apiClient.GetESClient().SlmGetLifecycle(req, apiClient.GetESClient().SlmGetLifecycle.WithContext(ctx))
@@ -112,15 +179,12 @@ func GetSlm(ctx context.Context, apiClient *clients.ApiClient, slmName string) (
 	if diags := utils.CheckError(res, "Unable to get SLM policy from ES API"); diags.HasError() {
 		return nil, diags
 	}
-	type SlmResponse = map[string]struct {
-		Policy models.SnapshotPolicy `json:"policy"`
-	}
-	var slmResponse SlmResponse
+	var slmResponse map[string]models.SnapshotPolicyInfo
 	if err := json.NewDecoder(res.Body).Decode(&slmResponse); err != nil {
 		return nil, diag.FromErr(err)
 	}
 	if slm, ok := slmResponse[slmName]; ok {
-		return &slm.Policy, diags
+		return &slm, diags
 	}
 	diags = append(diags, diag.Diagnostic{
 		Severity: diag.Error,
@@ -130,6 +194,25 @@ func GetSlm(ctx context.Context, apiClient *clients.ApiClient, slmName string) (
 	return nil, diags
 }
 
+// ExecuteSlm immediately creates a snapshot according to the SLM policy and returns the name of the snapshot
+func ExecuteSlm(ctx context.Context, apiClient *clients.ApiClient, slmName string) (string, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().SlmExecuteLifecycle(slmName, apiClient.GetESClient().SlmExecuteLifecycle.WithContext(ctx))
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to execute SLM policy: %s", slmName)); diags.HasError() {
+		return "", diags
+	}
+	var executeResponse struct {
+		SnapshotName string `json:"snapshot_name"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&executeResponse); err != nil {
+		return "", diag.FromErr(err)
+	}
+	return executeResponse.SnapshotName, nil
+}
+
 func DeleteSlm(ctx context.Context, apiClient *clients.ApiClient, slmName string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	res, err := apiClient.GetESClient().SlmDeleteLifecycle(slmName, apiClient.GetESClient().SlmDeleteLifecycle.WithContext(ctx))
@@ -144,6 +227,167 @@ func DeleteSlm(ctx context.Context, apiClient *clients.ApiClient, slmName string
 	return diags
 }
 
+func GetSlmStatus(ctx context.Context, apiClient *clients.ApiClient) (string, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().SlmGetStatus(apiClient.GetESClient().SlmGetStatus.WithContext(ctx))
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to get SLM status"); diags.HasError() {
+		return "", diags
+	}
+	var statusResponse struct {
+		OperationMode string `json:"operation_mode"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&statusResponse); err != nil {
+		return "", diag.FromErr(err)
+	}
+	return statusResponse.OperationMode, nil
+}
+
+func StartSlm(ctx context.Context, apiClient *clients.ApiClient) diag.Diagnostics {
+	res, err := apiClient.GetESClient().SlmStart(apiClient.GetESClient().SlmStart.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to start SLM"); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func StopSlm(ctx context.Context, apiClient *clients.ApiClient) diag.Diagnostics {
+	res, err := apiClient.GetESClient().SlmStop(apiClient.GetESClient().SlmStop.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to stop SLM"); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func CreateSnapshot(ctx context.Context, apiClient *clients.ApiClient, snapshot *models.Snapshot) diag.Diagnostics {
+	snapshotBytes, err := json.Marshal(snapshot)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	esClient := apiClient.GetESClient()
+	res, err := esClient.Snapshot.Create(snapshot.Repository, snapshot.Name, esClient.Snapshot.Create.WithBody(bytes.NewReader(snapshotBytes)), esClient.Snapshot.Create.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to create snapshot: %s", snapshot.Name)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
+func GetSnapshot(ctx context.Context, apiClient *clients.ApiClient, repository, name string) (*models.SnapshotInfo, diag.Diagnostics) {
+	esClient := apiClient.GetESClient()
+	res, err := esClient.Snapshot.Get(repository, []string{name}, esClient.Snapshot.Get.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get snapshot: %s", name)); diags.HasError() {
+		return nil, diags
+	}
+	var snapshotResponse struct {
+		Snapshots []models.SnapshotInfo `json:"snapshots"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&snapshotResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	for _, snapshot := range snapshotResponse.Snapshots {
+		if snapshot.Snapshot == name {
+			return &snapshot, nil
+		}
+	}
+	return nil, nil
+}
+
+func GetSnapshots(ctx context.Context, apiClient *clients.ApiClient, query *models.SnapshotsQuery) ([]models.SnapshotInfo, diag.Diagnostics) {
+	esClient := apiClient.GetESClient()
+	opts := []func(*esapi.SnapshotGetRequest){esClient.Snapshot.Get.WithContext(ctx)}
+	if query.Sort != "" {
+		opts = append(opts, esClient.Snapshot.Get.WithSort(query.Sort))
+	}
+	if query.Order != "" {
+		opts = append(opts, esClient.Snapshot.Get.WithOrder(query.Order))
+	}
+	if query.Size > 0 {
+		opts = append(opts, esClient.Snapshot.Get.WithSize(query.Size))
+	}
+	res, err := esClient.Snapshot.Get(query.Repository, []string{query.Name}, opts...)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get snapshots from the repository: %s", query.Repository)); diags.HasError() {
+		return nil, diags
+	}
+	var snapshotResponse struct {
+		Snapshots []models.SnapshotInfo `json:"snapshots"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&snapshotResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return snapshotResponse.Snapshots, nil
+}
+
+// RestoreSnapshot restores the snapshot and waits until the recovery of the primary shards of the restored indices completes
+func RestoreSnapshot(ctx context.Context, apiClient *clients.ApiClient, restore *models.SnapshotRestore) (*models.SnapshotRestoreInfo, diag.Diagnostics) {
+	restoreBytes, err := json.Marshal(restore)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	esClient := apiClient.GetESClient()
+	res, err := esClient.Snapshot.Restore(
+		restore.Repository,
+		restore.Snapshot,
+		esClient.Snapshot.Restore.WithBody(bytes.NewReader(restoreBytes)),
+		esClient.Snapshot.Restore.WithWaitForCompletion(true),
+		esClient.Snapshot.Restore.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to restore snapshot: %s", restore.Snapshot)); diags.HasError() {
+		return nil, diags
+	}
+	var restoreResponse struct {
+		Snapshot models.SnapshotRestoreInfo `json:"snapshot"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&restoreResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &restoreResponse.Snapshot, nil
+}
+
+func DeleteSnapshot(ctx context.Context, apiClient *clients.ApiClient, repository, name string) diag.Diagnostics {
+	esClient := apiClient.GetESClient()
+	res, err := esClient.Snapshot.Delete(repository, name, esClient.Snapshot.Delete.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to delete snapshot: %s", name)); diags.HasError() {
+		return diags
+	}
+	return nil
+}
+
 func PutSettings(ctx context.Context, apiClient *clients.ApiClient, settings map[string]interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	settingsBytes, err := json.Marshal(settings)
@@ -161,10 +405,14 @@ func PutSettings(ctx context.Context, apiClient *clients.ApiClient, settings map
 	return diags
 }
 
+// GetSettings returns the flat persistent, transient and default cluster settings
 func GetSettings(ctx context.Context, apiClient *clients.ApiClient) (map[string]interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	req := apiClient.GetESClient().Cluster.GetSettings.WithFlatSettings(true)
-	res, err := apiClient.GetESClient().Cluster.GetSettings(req, apiClient.GetESClient().Cluster.GetSettings.WithContext(ctx))
+	res, err := apiClient.GetESClient().Cluster.GetSettings(
+		apiClient.GetESClient().Cluster.GetSettings.WithFlatSettings(true),
+		apiClient.GetESClient().Cluster.GetSettings.WithIncludeDefaults(true),
+		apiClient.GetESClient().Cluster.GetSettings.WithContext(ctx),
+	)
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
@@ -180,6 +428,59 @@ func GetSettings(ctx context.Context, apiClient *clients.ApiClient) (map[string]
 	return clusterSettings, diags
 }
 
+// GetNodes returns the nodes of the cluster sorted by their name
+func GetNodes(ctx context.Context, apiClient *clients.ApiClient) ([]models.ClusterNode, diag.Diagnostics) {
+	esClient := apiClient.GetESClient()
+	res, err := esClient.Nodes.Info(
+		esClient.Nodes.Info.WithContext(ctx),
+		esClient.Nodes.Info.WithFilterPath("nodes.*.name", "nodes.*.host", "nodes.*.ip", "nodes.*.attributes"),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to get the nodes of the cluster"); diags.HasError() {
+		return nil, diags
+	}
+
+	var nodesResponse struct {
+		Nodes map[string]models.ClusterNode `json:"nodes"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&nodesResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	nodes := make([]models.ClusterNode, 0, len(nodesResponse.Nodes))
+	for id, node := range nodesResponse.Nodes {
+		node.Id = id
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+	return nodes, nil
+}
+
+// GetShards returns all the shards of the cluster with the nodes they are allocated to
+func GetShards(ctx context.Context, apiClient *clients.ApiClient) ([]models.CatShard, diag.Diagnostics) {
+	esClient := apiClient.GetESClient()
+	res, err := esClient.Cat.Shards(
+		esClient.Cat.Shards.WithContext(ctx),
+		esClient.Cat.Shards.WithFormat("json"),
+		esClient.Cat.Shards.WithH("index", "shard", "prirep", "state", "node"),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to get the shards of the cluster"); diags.HasError() {
+		return nil, diags
+	}
+
+	shards := make([]models.CatShard, 0)
+	if err := json.NewDecoder(res.Body).Decode(&shards); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return shards, nil
+}
+
 func GetScript(ctx context.Context, apiClient *clients.ApiClient, id string) (*models.Script, diag.Diagnostics) {
 	res, err := apiClient.GetESClient().GetScript(id, apiClient.GetESClient().GetScript.WithContext(ctx))
 	if err != nil {
@@ -234,3 +535,62 @@ func DeleteScript(ctx context.Context, apiClient *clients.ApiClient, id string)
 	}
 	return nil
 }
+
+// ExecutePainlessScript runs the painless script in the `painless_test` context and returns its result
+func ExecutePainlessScript(ctx context.Context, apiClient *clients.ApiClient, script *models.Script) (interface{}, diag.Diagnostics) {
+	req := struct {
+		Script *models.Script `json:"script"`
+	}{
+		script,
+	}
+	scriptBytes, err := json.Marshal(req)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	esClient := apiClient.GetESClient()
+	res, err := esClient.ScriptsPainlessExecute(
+		esClient.ScriptsPainlessExecute.WithBody(bytes.NewReader(scriptBytes)),
+		esClient.ScriptsPainlessExecute.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to execute painless script"); diags.HasError() {
+		return nil, diags
+	}
+	var executeResponse struct {
+		Result interface{} `json:"result"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&executeResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return executeResponse.Result, nil
+}
+
+// RenderSearchTemplate renders the stored or inline search template into the search request body
+func RenderSearchTemplate(ctx context.Context, apiClient *clients.ApiClient, template *models.SearchTemplateRender) (map[string]interface{}, diag.Diagnostics) {
+	templateBytes, err := json.Marshal(template)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	esClient := apiClient.GetESClient()
+	res, err := esClient.RenderSearchTemplate(
+		esClient.RenderSearchTemplate.WithBody(bytes.NewReader(templateBytes)),
+		esClient.RenderSearchTemplate.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to render search template"); diags.HasError() {
+		return nil, diags
+	}
+	var renderResponse struct {
+		TemplateOutput map[string]interface{} `json:"template_output"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&renderResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return renderResponse.TemplateOutput, nil
+}
@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/elastic/go-elasticsearch/v7/esapi"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
@@ -215,10 +217,22 @@ func PutIndex(ctx context.Context, apiClient *clients.ApiClient, index *models.I
 		return diag.FromErr(err)
 	}
 
-	req := apiClient.GetESClient().Indices.Create.WithBody(bytes.NewReader(indexBytes))
-	res, err := apiClient.GetESClient().Indices.Create(index.Name, req, apiClient.GetESClient().Indices.Create.WithContext(ctx))
+	opts := []func(*esapi.IndicesCreateRequest){
+		apiClient.GetESClient().Indices.Create.WithBody(bytes.NewReader(indexBytes)),
+		apiClient.GetESClient().Indices.Create.WithContext(ctx),
+	}
+	if index.WaitForActiveShards != "" {
+		opts = append(opts, apiClient.GetESClient().Indices.Create.WithWaitForActiveShards(index.WaitForActiveShards))
+	}
+	if index.MasterTimeout > 0 {
+		opts = append(opts, apiClient.GetESClient().Indices.Create.WithMasterTimeout(index.MasterTimeout))
+	}
+	if index.Timeout > 0 {
+		opts = append(opts, apiClient.GetESClient().Indices.Create.WithTimeout(index.Timeout))
+	}
+	res, err := apiClient.GetESClient().Indices.Create(index.Name, opts...)
 	if err != nil {
-		diag.FromErr(err)
+		return diag.FromErr(err)
 	}
 	defer res.Body.Close()
 	if diags := utils.CheckError(res, fmt.Sprintf("Unable to create index: %s", index.Name)); diags.HasError() {
@@ -227,10 +241,19 @@ func PutIndex(ctx context.Context, apiClient *clients.ApiClient, index *models.I
 	return diags
 }
 
-func DeleteIndex(ctx context.Context, apiClient *clients.ApiClient, name string) diag.Diagnostics {
+func DeleteIndex(ctx context.Context, apiClient *clients.ApiClient, name string, masterTimeout, timeout time.Duration) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	res, err := apiClient.GetESClient().Indices.Delete([]string{name}, apiClient.GetESClient().Indices.Delete.WithContext(ctx))
+	opts := []func(*esapi.IndicesDeleteRequest){
+		apiClient.GetESClient().Indices.Delete.WithContext(ctx),
+	}
+	if masterTimeout > 0 {
+		opts = append(opts, apiClient.GetESClient().Indices.Delete.WithMasterTimeout(masterTimeout))
+	}
+	if timeout > 0 {
+		opts = append(opts, apiClient.GetESClient().Indices.Delete.WithTimeout(timeout))
+	}
+	res, err := apiClient.GetESClient().Indices.Delete([]string{name}, opts...)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -242,6 +265,51 @@ func DeleteIndex(ctx context.Context, apiClient *clients.ApiClient, name string)
 	return diags
 }
 
+func CloseIndex(ctx context.Context, apiClient *clients.ApiClient, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	res, err := apiClient.GetESClient().Indices.Close([]string{name}, apiClient.GetESClient().Indices.Close.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to close the index: %s", name)); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
+func OpenIndex(ctx context.Context, apiClient *clients.ApiClient, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	res, err := apiClient.GetESClient().Indices.Open([]string{name}, apiClient.GetESClient().Indices.Open.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to open the index: %s", name)); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
+// WaitForIndexHealth blocks until the index reaches at least the requested health status or the timeout expires
+func WaitForIndexHealth(ctx context.Context, apiClient *clients.ApiClient, name, status string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+	res, err := apiClient.GetESClient().Cluster.Health(
+		apiClient.GetESClient().Cluster.Health.WithIndex(name),
+		apiClient.GetESClient().Cluster.Health.WithWaitForStatus(status),
+		apiClient.GetESClient().Cluster.Health.WithTimeout(timeout),
+		apiClient.GetESClient().Cluster.Health.WithContext(ctx),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Index %s did not reach %s health status in %s", name, status, timeout)); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
 func GetIndex(ctx context.Context, apiClient *clients.ApiClient, name string) (*models.Index, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -268,6 +336,51 @@ func GetIndex(ctx context.Context, apiClient *clients.ApiClient, name string) (*
 	return &index, diags
 }
 
+// IndicesExist checks whether all the given indices exist
+func IndicesExist(ctx context.Context, apiClient *clients.ApiClient, names []string) (bool, diag.Diagnostics) {
+	res, err := apiClient.GetESClient().Indices.Exists(names, apiClient.GetESClient().Indices.Exists.WithContext(ctx))
+	if err != nil {
+		return false, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to check the existence of the indices: %v", names)); diags.HasError() {
+		return false, diags
+	}
+	return true, nil
+}
+
+// GetIndexSettings returns the flat settings explicitly set on the index together with the default values of all the other settings
+func GetIndexSettings(ctx context.Context, apiClient *clients.ApiClient, name string) (*models.IndexSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := apiClient.GetESClient().Indices.GetSettings(
+		apiClient.GetESClient().Indices.GetSettings.WithIndex(name),
+		apiClient.GetESClient().Indices.GetSettings.WithFlatSettings(true),
+		apiClient.GetESClient().Indices.GetSettings.WithIncludeDefaults(true),
+		apiClient.GetESClient().Indices.GetSettings.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get settings of the index: %s", name)); diags.HasError() {
+		return nil, diags
+	}
+
+	indices := make(map[string]models.IndexSettings)
+	if err := json.NewDecoder(res.Body).Decode(&indices); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	settings := indices[name]
+	return &settings, diags
+}
+
 func DeleteIndexAlias(ctx context.Context, apiClient *clients.ApiClient, index string, aliases []string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	res, err := apiClient.GetESClient().Indices.DeleteAlias([]string{index}, aliases, apiClient.GetESClient().Indices.DeleteAlias.WithContext(ctx))
@@ -299,6 +412,57 @@ func UpdateIndexAlias(ctx context.Context, apiClient *clients.ApiClient, index s
 	return diags
 }
 
+func GetAlias(ctx context.Context, apiClient *clients.ApiClient, aliasName string) (map[string]models.IndexAlias, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	req := apiClient.GetESClient().Indices.GetAlias.WithName(aliasName)
+	res, err := apiClient.GetESClient().Indices.GetAlias(req, apiClient.GetESClient().Indices.GetAlias.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get alias: %s", aliasName)); diags.HasError() {
+		return nil, diags
+	}
+
+	indices := make(map[string]struct {
+		Aliases map[string]models.IndexAlias `json:"aliases"`
+	})
+	if err := json.NewDecoder(res.Body).Decode(&indices); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	// map the alias definitions by the index they belong to
+	aliases := make(map[string]models.IndexAlias, len(indices))
+	for index, v := range indices {
+		if alias, ok := v.Aliases[aliasName]; ok {
+			alias.Name = aliasName
+			aliases[index] = alias
+		}
+	}
+	return aliases, diags
+}
+
+// UpdateAliases applies all the provided actions atomically using the _aliases API
+func UpdateAliases(ctx context.Context, apiClient *clients.ApiClient, actions []models.IndexAliasAction) diag.Diagnostics {
+	var diags diag.Diagnostics
+	actionsBytes, err := json.Marshal(map[string]interface{}{"actions": actions})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().Indices.UpdateAliases(bytes.NewReader(actionsBytes), apiClient.GetESClient().Indices.UpdateAliases.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to update aliases"); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
 func UpdateIndexSettings(ctx context.Context, apiClient *clients.ApiClient, index string, settings map[string]interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	settingsBytes, err := json.Marshal(settings)
@@ -331,6 +495,56 @@ func UpdateIndexMappings(ctx context.Context, apiClient *clients.ApiClient, inde
 	return diags
 }
 
+// Reindex starts copying all the documents from the source index into the destination index and returns the ID of the background task
+func Reindex(ctx context.Context, apiClient *clients.ApiClient, source, dest string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	reindexBytes, err := json.Marshal(map[string]interface{}{
+		"source": map[string]interface{}{"index": source},
+		"dest":   map[string]interface{}{"index": dest},
+	})
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().Reindex(
+		bytes.NewReader(reindexBytes),
+		apiClient.GetESClient().Reindex.WithWaitForCompletion(false),
+		apiClient.GetESClient().Reindex.WithContext(ctx),
+	)
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to reindex %s into %s", source, dest)); diags.HasError() {
+		return "", diags
+	}
+
+	var task struct {
+		Task string `json:"task"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&task); err != nil {
+		return "", diag.FromErr(err)
+	}
+	return task.Task, diags
+}
+
+func GetTask(ctx context.Context, apiClient *clients.ApiClient, taskId string) (*models.Task, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	res, err := apiClient.GetESClient().Tasks.Get(taskId, apiClient.GetESClient().Tasks.Get.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, fmt.Sprintf("Unable to get the task: %s", taskId)); diags.HasError() {
+		return nil, diags
+	}
+
+	var task models.Task
+	if err := json.NewDecoder(res.Body).Decode(&task); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &task, diags
+}
+
 func PutDataStream(ctx context.Context, apiClient *clients.ApiClient, dataStreamName string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -429,6 +643,38 @@ func GetIngestPipeline(ctx context.Context, apiClient *clients.ApiClient, name *
 	return &pipeline, diags
 }
 
+// SimulateIngestPipeline runs the stored or the inline pipeline against the documents and returns the resulting documents
+func SimulateIngestPipeline(ctx context.Context, apiClient *clients.ApiClient, simulation *models.IngestPipelineSimulation) ([]models.IngestSimulatedDocument, diag.Diagnostics) {
+	simulationBytes, err := json.Marshal(simulation)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	esClient := apiClient.GetESClient()
+	opts := []func(*esapi.IngestSimulateRequest){
+		esClient.Ingest.Simulate.WithVerbose(simulation.Verbose),
+		esClient.Ingest.Simulate.WithContext(ctx),
+	}
+	if simulation.PipelineID != "" {
+		opts = append(opts, esClient.Ingest.Simulate.WithPipelineID(simulation.PipelineID))
+	}
+	res, err := esClient.Ingest.Simulate(bytes.NewReader(simulationBytes), opts...)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to simulate ingest pipeline"); diags.HasError() {
+		return nil, diags
+	}
+
+	var simulateResponse struct {
+		Docs []models.IngestSimulatedDocument `json:"docs"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&simulateResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return simulateResponse.Docs, nil
+}
+
 func DeleteIngestPipeline(ctx context.Context, apiClient *clients.ApiClient, name *string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -0,0 +1,37 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+)
+
+// performRequest sends the request to the API endpoint, which is not available in the esapi package of the used client version.
+// The returned response must be closed by the caller, the same way as the esapi responses.
+func performRequest(ctx context.Context, apiClient *clients.ApiClient, method, path string, body []byte) (*esapi.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, path, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := apiClient.GetESClient().Perform(req)
+	if err != nil {
+		return nil, err
+	}
+	return &esapi.Response{
+		StatusCode: res.StatusCode,
+		Body:       res.Body,
+		Header:     res.Header,
+	}, nil
+}
@@ -6,7 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 
+	"github.com/elastic/go-elasticsearch/v7/esapi"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
@@ -123,6 +126,75 @@ func ChangeUserPassword(ctx context.Context, apiClient *clients.ApiClient, usern
 	return diags
 }
 
+// GetPasswordHashingAlgorithm returns the password hashing algorithm configured on the nodes of the cluster
+func GetPasswordHashingAlgorithm(ctx context.Context, apiClient *clients.ApiClient) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	esClient := apiClient.GetESClient()
+	res, err := esClient.Nodes.Info(
+		esClient.Nodes.Info.WithContext(ctx),
+		esClient.Nodes.Info.WithMetric("settings"),
+	)
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to get the nodes settings"); diags.HasError() {
+		return "", diags
+	}
+
+	var nodesInfo struct {
+		Nodes map[string]struct {
+			Name     string                 `json:"name"`
+			Settings map[string]interface{} `json:"settings"`
+		} `json:"nodes"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&nodesInfo); err != nil {
+		return "", diag.FromErr(err)
+	}
+	if len(nodesInfo.Nodes) == 0 {
+		return "", diag.Errorf("Unable to read the password hashing algorithm, no nodes settings were returned")
+	}
+
+	algorithm := ""
+	for _, id := range utils.SortedKeys(nodesInfo.Nodes) {
+		node := nodesInfo.Nodes[id]
+		if node.Settings == nil {
+			return "", diag.Errorf(`Unable to read the password hashing algorithm, the settings of the node "%s" were not returned`, node.Name)
+		}
+		nodeAlgorithm, ok := lookupNodeSetting(node.Settings, "xpack.security.authc.password_hashing.algorithm")
+		if !ok {
+			// the setting is not configured on the node, so the node uses the default algorithm of its FIPS 140-2 mode
+			nodeAlgorithm = "bcrypt"
+			if fipsMode, _ := lookupNodeSetting(node.Settings, "xpack.security.fips_mode.enabled"); fipsMode == "true" {
+				nodeAlgorithm = "pbkdf2_stretch"
+			}
+		}
+		if algorithm != "" && !strings.EqualFold(algorithm, nodeAlgorithm) {
+			return "", diag.Errorf(`The nodes of the cluster are configured with the different password hashing algorithms "%s" and "%s"`, algorithm, nodeAlgorithm)
+		}
+		algorithm = nodeAlgorithm
+	}
+	return algorithm, diags
+}
+
+// Returns the value of the node setting from the nested settings, e.g. {"xpack": {"security": {...}}}
+func lookupNodeSetting(settings map[string]interface{}, key string) (string, bool) {
+	if v, ok := settings[key].(string); ok {
+		return v, true
+	}
+	for i := range key {
+		if key[i] != '.' {
+			continue
+		}
+		if nested, ok := settings[key[:i]].(map[string]interface{}); ok {
+			if v, ok := lookupNodeSetting(nested, key[i+1:]); ok {
+				return v, true
+			}
+		}
+	}
+	return "", false
+}
+
 func PutRole(ctx context.Context, apiClient *clients.ApiClient, role *models.Role) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -187,6 +259,64 @@ func DeleteRole(ctx context.Context, apiClient *clients.ApiClient, rolename stri
 	return diags
 }
 
+func GetBuiltinPrivileges(ctx context.Context, apiClient *clients.ApiClient) (*models.BuiltinPrivileges, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	res, err := apiClient.GetESClient().Security.GetBuiltinPrivileges(apiClient.GetESClient().Security.GetBuiltinPrivileges.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to get builtin privileges"); diags.HasError() {
+		return nil, diags
+	}
+
+	var privileges models.BuiltinPrivileges
+	if err := json.NewDecoder(res.Body).Decode(&privileges); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &privileges, diags
+}
+
+func Authenticate(ctx context.Context, apiClient *clients.ApiClient) (*models.AuthenticateResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	res, err := apiClient.GetESClient().Security.Authenticate(apiClient.GetESClient().Security.Authenticate.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to authenticate"); diags.HasError() {
+		return nil, diags
+	}
+
+	var authentication models.AuthenticateResponse
+	if err := json.NewDecoder(res.Body).Decode(&authentication); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &authentication, diags
+}
+
+func HasPrivileges(ctx context.Context, apiClient *clients.ApiClient, request *models.HasPrivilegesRequest) (*models.HasPrivilegesResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().Security.HasPrivileges(bytes.NewReader(requestBytes), apiClient.GetESClient().Security.HasPrivileges.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to check the privileges"); diags.HasError() {
+		return nil, diags
+	}
+
+	var privileges models.HasPrivilegesResponse
+	if err := json.NewDecoder(res.Body).Decode(&privileges); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &privileges, diags
+}
+
 func PutRoleMapping(ctx context.Context, apiClient *clients.ApiClient, roleMapping *models.RoleMapping) diag.Diagnostics {
 	roleMappingBytes, err := json.Marshal(roleMapping)
 	if err != nil {
@@ -269,6 +399,90 @@ func PutApiKey(apiClient *clients.ApiClient, apikey *models.ApiKey) (*models.Api
 	return &apiKey, diags
 }
 
+// GrantApiKey creates an API key on behalf of the user identified by the grant credentials
+func GrantApiKey(ctx context.Context, apiClient *clients.ApiClient, grant *models.ApiKeyGrant) (*models.ApiKeyResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	grantBytes, err := json.Marshal(grant)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	res, err := apiClient.GetESClient().Security.GrantAPIKey(bytes.NewReader(grantBytes), apiClient.GetESClient().Security.GrantAPIKey.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to grant apikey"); diags.HasError() {
+		return nil, diags
+	}
+
+	var apiKey models.ApiKeyResponse
+	if err := json.NewDecoder(res.Body).Decode(&apiKey); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &apiKey, diags
+}
+
+// UpdateApiKey updates the role descriptors, metadata and expiration of the existing API key, keeping its credentials unchanged
+func UpdateApiKey(ctx context.Context, apiClient *clients.ApiClient, id string, apikey *models.ApiKeyUpdate) diag.Diagnostics {
+	var diags diag.Diagnostics
+	apikeyBytes, err := json.Marshal(apikey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := performRequest(ctx, apiClient, http.MethodPut, "/_security/api_key/"+url.PathEscape(id), apikeyBytes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to update apikey"); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
+func PutCrossClusterApiKey(ctx context.Context, apiClient *clients.ApiClient, apikey *models.CrossClusterApiKey) (*models.ApiKeyResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	apikeyBytes, err := json.Marshal(apikey)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	res, err := performRequest(ctx, apiClient, http.MethodPost, "/_security/cross_cluster/api_key", apikeyBytes)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to create cross-cluster apikey"); diags.HasError() {
+		return nil, diags
+	}
+
+	var apiKey models.ApiKeyResponse
+	if err := json.NewDecoder(res.Body).Decode(&apiKey); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &apiKey, diags
+}
+
+func UpdateCrossClusterApiKey(ctx context.Context, apiClient *clients.ApiClient, id string, apikey *models.CrossClusterApiKeyUpdate) diag.Diagnostics {
+	var diags diag.Diagnostics
+	apikeyBytes, err := json.Marshal(apikey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := performRequest(ctx, apiClient, http.MethodPut, "/_security/cross_cluster/api_key/"+url.PathEscape(id), apikeyBytes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to update cross-cluster apikey"); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
 func GetApiKey(apiClient *clients.ApiClient, id string) (*models.ApiKeyResponse, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	req := apiClient.GetESClient().Security.GetAPIKey.WithID(id)
@@ -333,3 +547,180 @@ func DeleteApiKey(apiClient *clients.ApiClient, id string) diag.Diagnostics {
 	}
 	return diags
 }
+
+func GetServiceAccounts(ctx context.Context, apiClient *clients.ApiClient, namespace, service string) (map[string]models.ServiceAccount, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	esClient := apiClient.GetESClient()
+	opts := []func(*esapi.SecurityGetServiceAccountsRequest){esClient.Security.GetServiceAccounts.WithContext(ctx)}
+	if namespace != "" {
+		opts = append(opts, esClient.Security.GetServiceAccounts.WithNamespace(namespace))
+	}
+	if service != "" {
+		opts = append(opts, esClient.Security.GetServiceAccounts.WithService(service))
+	}
+	res, err := esClient.Security.GetServiceAccounts(opts...)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	// an unknown namespace or service results in the empty response
+	if diags := utils.CheckError(res, "Unable to get service accounts"); diags.HasError() {
+		return nil, diags
+	}
+
+	accounts := make(map[string]models.ServiceAccount)
+	if err := json.NewDecoder(res.Body).Decode(&accounts); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return accounts, diags
+}
+
+func CreateServiceAccountToken(ctx context.Context, apiClient *clients.ApiClient, namespace, service, name string) (*models.ServiceAccountToken, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	esClient := apiClient.GetESClient()
+	res, err := esClient.Security.CreateServiceToken(namespace, service, esClient.Security.CreateServiceToken.WithName(name), esClient.Security.CreateServiceToken.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to create service account token"); diags.HasError() {
+		return nil, diags
+	}
+
+	var tokenResponse struct {
+		Created bool                       `json:"created"`
+		Token   models.ServiceAccountToken `json:"token"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&tokenResponse); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &tokenResponse.Token, diags
+}
+
+func GetServiceAccountCredentials(ctx context.Context, apiClient *clients.ApiClient, namespace, service string) (*models.ServiceAccountCredentials, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	esClient := apiClient.GetESClient()
+	res, err := esClient.Security.GetServiceCredentials(namespace, service, esClient.Security.GetServiceCredentials.WithContext(ctx))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if diags := utils.CheckError(res, "Unable to get service account credentials"); diags.HasError() {
+		return nil, diags
+	}
+
+	var credentials models.ServiceAccountCredentials
+	if err := json.NewDecoder(res.Body).Decode(&credentials); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return &credentials, diags
+}
+
+func DeleteServiceAccountToken(ctx context.Context, apiClient *clients.ApiClient, namespace, service, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	esClient := apiClient.GetESClient()
+	res, err := esClient.Security.DeleteServiceToken(namespace, service, name, esClient.Security.DeleteServiceToken.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return diags
+	}
+	if diags := utils.CheckError(res, "Unable to delete service account token"); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
+func PutApplicationPrivilege(ctx context.Context, apiClient *clients.ApiClient, privilege *models.ApplicationPrivilege) diag.Diagnostics {
+	var diags diag.Diagnostics
+	// the application and the name are the keys of the request body
+	privileges := map[string]map[string]models.ApplicationPrivilege{
+		privilege.Application: {
+			privilege.Name: models.ApplicationPrivilege{
+				Actions:  privilege.Actions,
+				Metadata: privilege.Metadata,
+			},
+		},
+	}
+	privilegesBytes, err := json.Marshal(privileges)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	res, err := apiClient.GetESClient().Security.PutPrivileges(bytes.NewReader(privilegesBytes), apiClient.GetESClient().Security.PutPrivileges.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to put application privilege"); diags.HasError() {
+		return diags
+	}
+	return diags
+}
+
+// GetApplicationPrivileges returns all the privileges of the application keyed by the privilege name
+func GetApplicationPrivileges(ctx context.Context, apiClient *clients.ApiClient, application string) (map[string]models.ApplicationPrivilege, diag.Diagnostics) {
+	return getApplicationPrivileges(ctx, apiClient, application, "")
+}
+
+func GetApplicationPrivilege(ctx context.Context, apiClient *clients.ApiClient, application, name string) (*models.ApplicationPrivilege, diag.Diagnostics) {
+	privileges, diags := getApplicationPrivileges(ctx, apiClient, application, name)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if privilege, ok := privileges[name]; ok {
+		return &privilege, diags
+	}
+	return nil, diags
+}
+
+func getApplicationPrivileges(ctx context.Context, apiClient *clients.ApiClient, application, name string) (map[string]models.ApplicationPrivilege, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	esClient := apiClient.GetESClient()
+	opts := []func(*esapi.SecurityGetPrivilegesRequest){
+		esClient.Security.GetPrivileges.WithContext(ctx),
+		esClient.Security.GetPrivileges.WithApplication(application),
+	}
+	if name != "" {
+		opts = append(opts, esClient.Security.GetPrivileges.WithName(name))
+	}
+	res, err := esClient.Security.GetPrivileges(opts...)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	// the unknown application or privilege results in the empty response with the not found status
+	if res.StatusCode == http.StatusNotFound {
+		return map[string]models.ApplicationPrivilege{}, diags
+	}
+	if diags := utils.CheckError(res, "Unable to get application privileges"); diags.HasError() {
+		return nil, diags
+	}
+
+	applications := make(map[string]map[string]models.ApplicationPrivilege)
+	if err := json.NewDecoder(res.Body).Decode(&applications); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	privileges, ok := applications[application]
+	if !ok {
+		return map[string]models.ApplicationPrivilege{}, diags
+	}
+	return privileges, diags
+}
+
+func DeleteApplicationPrivilege(ctx context.Context, apiClient *clients.ApiClient, application, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	res, err := apiClient.GetESClient().Security.DeletePrivileges(name, application, apiClient.GetESClient().Security.DeletePrivileges.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer res.Body.Close()
+	if diags := utils.CheckError(res, "Unable to delete application privilege"); diags.HasError() {
+		return diags
+	}
+	return diags
+}
@@ -0,0 +1,349 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const nodeExclusionSettingPrefix = "cluster.routing.allocation.exclude."
+
+// The built-in node attributes of the exclusion filters mapped to the resource fields
+var nodeExclusionBuiltInAttributes = map[string]string{
+	"names": "_name",
+	"ips":   "_ip",
+	"hosts": "_host",
+}
+
+func ResourceNodeExclusion() *schema.Resource {
+	exclusionFields := []string{"names", "ips", "hosts", "attributes"}
+
+	exclusionSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"names": {
+			Description:  "The names of the nodes to exclude from the shard allocation. Supports the wildcard patterns.",
+			Type:         schema.TypeSet,
+			Optional:     true,
+			AtLeastOneOf: exclusionFields,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"ips": {
+			Description:  "The IP addresses of the nodes to exclude from the shard allocation. Supports the wildcard patterns.",
+			Type:         schema.TypeSet,
+			Optional:     true,
+			AtLeastOneOf: exclusionFields,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"hosts": {
+			Description:  "The host names of the nodes to exclude from the shard allocation. Supports the wildcard patterns.",
+			Type:         schema.TypeSet,
+			Optional:     true,
+			AtLeastOneOf: exclusionFields,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"attributes": {
+			Description:  "The custom node attributes to exclude the nodes from the shard allocation by, e.g. `rack = \"rack1,rack2\"`. The values are comma-separated lists, supporting the wildcard patterns.",
+			Type:         schema.TypeMap,
+			Optional:     true,
+			AtLeastOneOf: exclusionFields,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"wait_for_relocation": {
+			Description: "If `true`, wait until no shards remain on the excluded nodes.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"excluded_nodes": {
+			Description: "The names of the nodes currently matching the exclusion.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(exclusionSchema)
+
+	return &schema.Resource{
+		Description: "Excludes the nodes from the shard allocation, e.g. before the maintenance of the nodes, and waits until their shards are moved off. The entries are added to the `cluster.routing.allocation.exclude.*` persistent cluster settings, keeping the entries configured otherwise, and removed on destroy. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/modules-cluster.html#cluster-shard-allocation-filtering",
+
+		CreateContext: resourceNodeExclusionPut,
+		UpdateContext: resourceNodeExclusionPut,
+		ReadContext:   resourceNodeExclusionRead,
+		DeleteContext: resourceNodeExclusionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: exclusionSchema,
+	}
+}
+
+func resourceNodeExclusionPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	id, diags := client.ID(ctx, "node-exclusion")
+	if diags.HasError() {
+		return diags
+	}
+
+	exclusions := expandNodeExclusions(d.Get)
+	previous := map[string][]string{}
+	if !d.IsNewResource() {
+		previous = expandNodeExclusions(func(key string) interface{} {
+			old, _ := d.GetChange(key)
+			return old
+		})
+	}
+	if diags := updateNodeExclusions(ctx, client, previous, exclusions); diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if d.Get("wait_for_relocation").(bool) {
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutCreate)
+		}
+		if diags := waitForNodeExclusion(ctx, client, exclusions, timeout); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceNodeExclusionRead(ctx, d, meta)
+}
+
+// Returns the excluded values by the setting name suffix, e.g. `_name` or a custom attribute
+func expandNodeExclusions(get func(string) interface{}) map[string][]string {
+	exclusions := make(map[string][]string)
+	for field, attribute := range nodeExclusionBuiltInAttributes {
+		if v, ok := get(field).(*schema.Set); ok && v.Len() > 0 {
+			exclusions[attribute] = utils.ExpandStringSet(v)
+		}
+	}
+	if v, ok := get("attributes").(map[string]interface{}); ok {
+		for attribute, values := range v {
+			if list := settingValueList(values); len(list) > 0 {
+				exclusions[attribute] = list
+			}
+		}
+	}
+	return exclusions
+}
+
+// Replaces the previous exclusions with the new ones, keeping the entries which are not managed by the resource
+func updateNodeExclusions(ctx context.Context, client *clients.ApiClient, previous, exclusions map[string][]string) diag.Diagnostics {
+	clusterSettings, diags := elasticsearch.GetSettings(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+	persistent, _ := clusterSettings["persistent"].(map[string]interface{})
+
+	attributes := make(map[string]bool)
+	for attribute := range previous {
+		attributes[attribute] = true
+	}
+	for attribute := range exclusions {
+		attributes[attribute] = true
+	}
+
+	settings := make(map[string]interface{})
+	for attribute := range attributes {
+		key := nodeExclusionSettingPrefix + attribute
+		current := settingValueList(persistent[key])
+		values := make([]string, 0, len(current))
+		for _, v := range current {
+			if !contains(previous[attribute], v) || contains(exclusions[attribute], v) {
+				values = append(values, v)
+			}
+		}
+		for _, v := range exclusions[attribute] {
+			if !contains(values, v) {
+				values = append(values, v)
+			}
+		}
+
+		if len(values) == 0 {
+			settings[key] = nil
+		} else {
+			settings[key] = strings.Join(values, ",")
+		}
+	}
+	if len(settings) == 0 {
+		return diags
+	}
+	return elasticsearch.PutSettings(ctx, client, map[string]interface{}{"persistent": settings})
+}
+
+// Polls the shards of the cluster until none of them is allocated to the excluded nodes
+func waitForNodeExclusion(ctx context.Context, client *clients.ApiClient, exclusions map[string][]string, timeout time.Duration) diag.Diagnostics {
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		nodes, diags := elasticsearch.GetNodes(ctx, client)
+		if diags.HasError() {
+			return resource.NonRetryableError(fmt.Errorf("unable to get the nodes: %v", diags))
+		}
+		shards, diags := elasticsearch.GetShards(ctx, client)
+		if diags.HasError() {
+			return resource.NonRetryableError(fmt.Errorf("unable to get the shards: %v", diags))
+		}
+
+		excluded := excludedNodeNames(nodes, exclusions)
+		remaining := 0
+		for _, shard := range shards {
+			// the relocating shards are reported as `<source node> -> <target node>`
+			if node := strings.Fields(shard.Node); len(node) > 0 && contains(excluded, node[0]) {
+				remaining++
+			}
+		}
+		if remaining > 0 {
+			tflog.Debug(ctx, fmt.Sprintf("%d shards remain on the excluded nodes %v", remaining, excluded))
+			return resource.RetryableError(fmt.Errorf("%d shards remain on the excluded nodes %v", remaining, excluded))
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// Returns the names of the nodes matching any of the exclusions
+func excludedNodeNames(nodes []models.ClusterNode, exclusions map[string][]string) []string {
+	result := make([]string, 0)
+	for _, node := range nodes {
+		for attribute, patterns := range exclusions {
+			var value string
+			switch attribute {
+			case "_name":
+				value = node.Name
+			case "_ip":
+				value = node.Ip
+			case "_host":
+				value = node.Host
+			default:
+				value = node.Attributes[attribute]
+			}
+			if value != "" && matchesAnyPattern(value, patterns) {
+				result = append(result, node.Name)
+				break
+			}
+		}
+	}
+	return result
+}
+
+func matchesAnyPattern(value string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if regexp.MustCompile("^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*") + "$").MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceNodeExclusionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	clusterSettings, diags := elasticsearch.GetSettings(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+	persistent, _ := clusterSettings["persistent"].(map[string]interface{})
+
+	// keep only the managed entries still present in the cluster settings, so the drift is detected
+	exclusions := expandNodeExclusions(d.Get)
+	current := make(map[string][]string)
+	for attribute, values := range exclusions {
+		set := settingValueList(persistent[nodeExclusionSettingPrefix+attribute])
+		for _, v := range values {
+			if contains(set, v) {
+				current[attribute] = append(current[attribute], v)
+			}
+		}
+	}
+	if len(current) == 0 {
+		tflog.Warn(ctx, "None of the node exclusions found in the cluster settings, removing from state")
+		d.SetId("")
+		return diags
+	}
+
+	for field, attribute := range nodeExclusionBuiltInAttributes {
+		if err := d.Set(field, current[attribute]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	attributes := make(map[string]interface{})
+	configuredAttributes := d.Get("attributes").(map[string]interface{})
+	for attribute, values := range current {
+		if strings.HasPrefix(attribute, "_") {
+			continue
+		}
+		// keep the configured value, if all of its entries are present
+		if len(values) == len(exclusions[attribute]) {
+			attributes[attribute] = configuredAttributes[attribute]
+		} else {
+			attributes[attribute] = strings.Join(values, ",")
+		}
+	}
+	if err := d.Set("attributes", attributes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	nodes, diags := elasticsearch.GetNodes(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+	if err := d.Set("excluded_nodes", excludedNodeNames(nodes, current)); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+// Removes the managed entries from the exclusion lists, the shards are moved back by the cluster on its own
+func resourceNodeExclusionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	return updateNodeExclusions(ctx, client, expandNodeExclusions(d.Get), map[string][]string{})
+}
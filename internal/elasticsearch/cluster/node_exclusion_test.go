@@ -0,0 +1,96 @@
+package cluster_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNodeExclusion(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceNodeExclusionDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNodeExclusionCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_node_exclusion.test", "names.*", "tf-acc-maintenance-node"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_node_exclusion.test", "attributes.rack", "tf-acc-rack1,tf-acc-rack2"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_node_exclusion.test", "excluded_nodes.#", "0"),
+				),
+			},
+			{
+				Config: testAccResourceNodeExclusionUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_node_exclusion.test", "names.#", "0"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_node_exclusion.test", "ips.*", "192.0.2.10"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_node_exclusion.test", "attributes.rack", "tf-acc-rack1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceNodeExclusionCreate = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_node_exclusion" "test" {
+  names = ["tf-acc-maintenance-node"]
+  attributes = {
+    rack = "tf-acc-rack1,tf-acc-rack2"
+  }
+}
+`
+
+const testAccResourceNodeExclusionUpdate = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_node_exclusion" "test" {
+  ips = ["192.0.2.10"]
+  attributes = {
+    rack = "tf-acc-rack1"
+  }
+}
+`
+
+func checkResourceNodeExclusionDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_node_exclusion" {
+			continue
+		}
+
+		req := client.GetESClient().Cluster.GetSettings.WithFlatSettings(true)
+		res, err := client.GetESClient().Cluster.GetSettings(req)
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+
+		clusterSettings := make(map[string]map[string]interface{})
+		if err := json.NewDecoder(res.Body).Decode(&clusterSettings); err != nil {
+			return err
+		}
+		for k, v := range clusterSettings["persistent"] {
+			if strings.HasPrefix(k, "cluster.routing.allocation.exclude.") && strings.Contains(fmt.Sprintf("%v", v), "tf-acc-") {
+				return fmt.Errorf(`Node exclusion "%s=%v" still in the cluster, but it should be removed`, k, v)
+			}
+		}
+	}
+	return nil
+}
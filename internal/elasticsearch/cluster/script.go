@@ -46,6 +46,28 @@ func ResourceScript() *schema.Resource {
 			Type:        schema.TypeString,
 			Optional:    true,
 		},
+		"validation": {
+			Description: "Validates the painless script at the plan time, by executing it in the `painless_test` context. See https://www.elastic.co/guide/en/elasticsearch/painless/current/painless-execute-api.html",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"params": {
+						Description:      "Parameters to execute the script with. Defaults to the `params` of the script.",
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: utils.DiffJsonSuppress,
+						ValidateFunc:     validation.StringIsJSON,
+					},
+					"expected_result": {
+						Description: "The expected result of the script execution. The string results are compared as is, the other results in their JSON representation.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+				},
+			},
+		},
 	}
 	utils.AddConnectionSchema(scriptSchema)
 
@@ -61,10 +83,72 @@ func ResourceScript() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: validatePainlessScript,
+
 		Schema: scriptSchema,
 	}
 }
 
+// Executes the painless script with the test parameters, so the compile errors and the unexpected results are reported at the plan time
+func validatePainlessScript(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("validation")
+	if !ok {
+		return nil
+	}
+	if lang := d.Get("lang").(string); lang != "painless" {
+		return fmt.Errorf("'validation' is supported only for the painless scripts, got '%s'", lang)
+	}
+	// the script can be validated only when its source and params are known
+	if !d.NewValueKnown("source") || !d.NewValueKnown("params") || !d.NewValueKnown("validation") {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChanges("source", "params", "validation", "lang") {
+		return nil
+	}
+
+	validation, _ := v.([]interface{})[0].(map[string]interface{})
+	paramsJSON, _ := validation["params"].(string)
+	if paramsJSON == "" {
+		paramsJSON = d.Get("params").(string)
+	}
+	params := make(map[string]interface{})
+	if paramsJSON != "" {
+		if err := json.Unmarshal([]byte(paramsJSON), &params); err != nil {
+			return err
+		}
+	}
+
+	client, diags := clients.NewApiClientFromDiff(d, meta)
+	if diags.HasError() {
+		return fmt.Errorf("unable to create the Elasticsearch client: %v", diags)
+	}
+	result, diags := elasticsearch.ExecutePainlessScript(ctx, client, &models.Script{
+		Language: "painless",
+		Source:   d.Get("source").(string),
+		Params:   params,
+	})
+	if diags.HasError() {
+		return fmt.Errorf("the painless script is not valid: %v", diags)
+	}
+
+	expected, _ := validation["expected_result"].(string)
+	if expected == "" {
+		return nil
+	}
+	actual, ok := result.(string)
+	if !ok {
+		resultBytes, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		actual = string(resultBytes)
+	}
+	if actual != expected {
+		return fmt.Errorf(`the painless script returned "%s", but "%s" is expected`, actual, expected)
+	}
+	return nil
+}
+
 func resourceScriptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClient(d, meta)
 	if diags.HasError() {
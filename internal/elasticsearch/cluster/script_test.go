@@ -2,6 +2,7 @@ package cluster_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
@@ -62,6 +63,34 @@ func TestAccResourceScriptSearchTemplate(t *testing.T) {
 	})
 }
 
+func TestAccResourceScriptValidation(t *testing.T) {
+	scriptID := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkScriptDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScriptValidation(scriptID, "params.a + params.", "3"),
+				ExpectError: regexp.MustCompile(`the painless script is not valid`),
+			},
+			{
+				Config:      testAccScriptValidation(scriptID, "params.a * params.b", "3"),
+				ExpectError: regexp.MustCompile(`the painless script returned "2", but "3" is expected`),
+			},
+			{
+				Config: testAccScriptValidation(scriptID, "params.a + params.b", "3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_script.test", "script_id", scriptID),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_script.test", "source", "params.a + params.b"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_script.test", "validation.0.expected_result", "3"),
+				),
+			},
+		},
+	})
+}
+
 func testAccScriptCreate(id string) string {
 	return fmt.Sprintf(`
 provider "elasticstack" {
@@ -119,6 +148,28 @@ resource "elasticstack_elasticsearch_script" "search_template_test" {
 	`, id)
 }
 
+func testAccScriptValidation(id, source, expected string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_script" "test" {
+  script_id = "%s"
+  lang      = "painless"
+  source    = "%s"
+
+  validation {
+    params = jsonencode({
+      a = 1
+      b = 2
+    })
+    expected_result = "%s"
+  }
+}
+	`, id, source, expected)
+}
+
 func checkScriptDestroy(s *terraform.State) error {
 	client, err := clients.NewAcceptanceTestingClient()
 	if err != nil {
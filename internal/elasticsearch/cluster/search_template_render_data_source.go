@@ -0,0 +1,100 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceSearchTemplateRender() *schema.Resource {
+	renderSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"template_id": {
+			Description:  "ID of the stored search template to render.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"template_id", "source"},
+		},
+		"source": {
+			Description:  "An inline search template to render.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"template_id", "source"},
+		},
+		"params": {
+			Description:  "Parameters used to replace the variables in the search template.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+		},
+		"rendered": {
+			Description: "The rendered search request body as JSON.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(renderSchema)
+
+	return &schema.Resource{
+		Description: "Renders the search template into the search request body, e.g. to assert the generated query in the tests of the modules. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/render-search-template-api.html",
+
+		ReadContext: dataSourceSearchTemplateRenderRead,
+
+		Schema: renderSchema,
+	}
+}
+
+func dataSourceSearchTemplateRenderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	template := models.SearchTemplateRender{
+		ID:     d.Get("template_id").(string),
+		Source: d.Get("source").(string),
+	}
+	if v, ok := d.GetOk("params"); ok {
+		params := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(v.(string)), &params); err != nil {
+			return diag.FromErr(err)
+		}
+		template.Params = params
+	}
+
+	rendered, diags := elasticsearch.RenderSearchTemplate(ctx, client, &template)
+	if diags.HasError() {
+		return diags
+	}
+	renderedBytes, err := json.Marshal(rendered)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("rendered", string(renderedBytes)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	hash, err := utils.StringToHash(fmt.Sprintf("%s:%s:%s", template.ID, template.Source, d.Get("params").(string)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id, diags := client.ID(ctx, *hash)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+	return diags
+}
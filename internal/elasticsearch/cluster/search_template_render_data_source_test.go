@@ -0,0 +1,73 @@
+package cluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSearchTemplateRender(t *testing.T) {
+	scriptID := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkScriptDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSearchTemplateRender(scriptID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_search_template_render.stored", "rendered", `{"from":"20","query":{"match":{"message":"hello world"}},"size":"10"}`),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_search_template_render.inline", "rendered", `{"query":{"term":{"user.id":"kimchy"}}}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSearchTemplateRender(id string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_script" "test" {
+  script_id = "%s"
+  lang      = "mustache"
+  source = jsonencode({
+    query = {
+      match = {
+        message = "{{query_string}}"
+      }
+    }
+    from = "{{from}}"
+    size = "{{size}}"
+  })
+}
+
+data "elasticstack_elasticsearch_search_template_render" "stored" {
+  template_id = elasticstack_elasticsearch_script.test.script_id
+  params = jsonencode({
+    query_string = "hello world"
+    from         = 20
+    size         = 10
+  })
+}
+
+data "elasticstack_elasticsearch_search_template_render" "inline" {
+  source = jsonencode({
+    query = {
+      term = {
+        "user.id" = "{{user_id}}"
+      }
+    }
+  })
+  params = jsonencode({
+    user_id = "kimchy"
+  })
+}
+`, id)
+}
@@ -2,7 +2,11 @@ package cluster
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
@@ -11,6 +15,29 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// The group and affix settings, which are not listed among the default cluster settings
+var clusterSettingsDynamicPrefixes = []string{
+	"cluster.metadata.",
+	"cluster.remote.",
+	"cluster.routing.allocation.awareness.force.",
+	"cluster.routing.allocation.exclude.",
+	"cluster.routing.allocation.include.",
+	"cluster.routing.allocation.require.",
+	"logger.",
+}
+
+// The type of the setting value, used to compare the configured and the current values
+type settingType int
+
+const (
+	settingTypeString settingType = iota
+	settingTypeBool
+	settingTypeNumber
+	settingTypeTime
+	settingTypeByteSize
+	settingTypeList
+)
+
 func ResourceSettings() *schema.Resource {
 	settingSchema := &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -70,7 +97,7 @@ func ResourceSettings() *schema.Resource {
 	utils.AddConnectionSchema(settingsSchema)
 
 	return &schema.Resource{
-		Description: "Updates cluster-wide settings. If the Elasticsearch security features are enabled, you must have the manage cluster privilege to use this API. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/cluster-update-settings.html",
+		Description: "Updates cluster-wide settings. The setting names are validated on plan, unless no other setting of their namespace is known, e.g. the affix settings like `xpack.monitoring.exporters.<name>.type`, which are validated by Elasticsearch on apply. The values are compared according to the type of the setting, e.g. `1gb` equals `1024mb`. Only the configured settings are tracked, and reset on destroy. If the Elasticsearch security features are enabled, you must have the manage cluster privilege to use this API. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/cluster-update-settings.html",
 
 		CreateContext: resourceClusterSettingsPut,
 		UpdateContext: resourceClusterSettingsPut,
@@ -81,10 +108,50 @@ func ResourceSettings() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeSettingsDiff,
+
 		Schema: settingsSchema,
 	}
 }
 
+// Fails on plan, if any of the configured settings is not known by the cluster
+func customizeSettingsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("persistent", "transient") {
+		return nil
+	}
+	configured := make(map[string]interface{})
+	for _, category := range []string{"persistent", "transient"} {
+		v, ok := d.GetOk(category)
+		if !ok {
+			continue
+		}
+		settings := make(map[string]interface{})
+		for _, setting := range v.([]interface{})[0].(map[string]interface{})["setting"].(*schema.Set).List() {
+			// the names unknown during the plan are read as empty strings
+			if name := setting.(map[string]interface{})["name"].(string); name != "" {
+				settings[name] = true
+			}
+		}
+		configured[category] = settings
+	}
+	if len(configured) == 0 {
+		return nil
+	}
+
+	client, diags := clients.NewApiClientFromDiff(d, meta)
+	if diags.HasError() {
+		return fmt.Errorf("unable to create the Elasticsearch client: %v", diags)
+	}
+	clusterSettings, diags := elasticsearch.GetSettings(ctx, client)
+	if diags.HasError() {
+		return fmt.Errorf("unable to get the cluster settings: %v", diags)
+	}
+	if diags := validateSettings(configured, clusterSettings); diags.HasError() {
+		return fmt.Errorf("%s %s", diags[0].Summary, diags[0].Detail)
+	}
+	return nil
+}
+
 func resourceClusterSettingsPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClient(d, meta)
 	if diags.HasError() {
@@ -99,6 +166,12 @@ func resourceClusterSettingsPut(ctx context.Context, d *schema.ResourceData, met
 	if diags.HasError() {
 		return diags
 	}
+	clusterSettings, diags := elasticsearch.GetSettings(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+	normalizeSettings(settings, clusterSettings)
+
 	for _, v := range []string{"persistent", "transient"} {
 		if d.HasChange(v) {
 			old, new := d.GetChange(v)
@@ -225,28 +298,35 @@ func resourceClusterSettingsRead(ctx context.Context, d *schema.ResourceData, me
 	return diags
 }
 
-func flattenSettings(name string, old, new map[string]interface{}) []interface{} {
+func flattenSettings(name string, configured, clusterSettings map[string]interface{}) []interface{} {
 	setting := make(map[string]interface{})
 	settings := make([]interface{}, 0)
 	result := make([]interface{}, 0)
 
-	if old[name] != nil {
-		for k := range old[name].(map[string]interface{}) {
-			if new[name] != nil {
-				if v, ok := new[name].(map[string]interface{})[k]; ok {
-					s := make(map[string]interface{})
-					s["name"] = k
-
-					// decide which value to set
-					switch t := v.(type) {
-					case string:
-						s["value"] = t
-					case []interface{}:
-						s["value_list"] = t
-					}
-					settings = append(settings, s)
-				}
+	if configured[name] != nil && clusterSettings[name] != nil {
+		current := clusterSettings[name].(map[string]interface{})
+		for k, cv := range configured[name].(map[string]interface{}) {
+			// the settings removed outside of the provider are dropped, so the drift is detected
+			v, ok := current[k]
+			if !ok {
+				continue
+			}
+			// keep the configured value, if it's equal to the current one according to the type of the setting
+			if settingValuesEqual(clusterSettingType(k, cv, clusterSettings), cv, v) {
+				v = cv
+			}
+
+			s := make(map[string]interface{})
+			s["name"] = k
+
+			// decide which value to set
+			switch t := v.(type) {
+			case string:
+				s["value"] = t
+			case []interface{}:
+				s["value_list"] = t
 			}
+			settings = append(settings, s)
 		}
 	}
 
@@ -257,33 +337,205 @@ func flattenSettings(name string, old, new map[string]interface{}) []interface{}
 	return result
 }
 
+// Checks, that all the configured settings are known to the cluster
+func validateSettings(configured, clusterSettings map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, category := range []string{"persistent", "transient"} {
+		settings, ok := configured[category].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, name := range utils.SortedKeys(settings) {
+			if !isKnownClusterSetting(name, clusterSettings) {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf(`Unknown cluster setting "%s".`, name),
+					Detail:   fmt.Sprintf(`The %s setting "%s" is not found among the cluster settings, including the defaults.`, category, name),
+				})
+			}
+		}
+	}
+	return diags
+}
+
+func isKnownClusterSetting(name string, clusterSettings map[string]interface{}) bool {
+	for _, category := range []string{"defaults", "persistent", "transient"} {
+		if settings, ok := clusterSettings[category].(map[string]interface{}); ok {
+			if _, ok := settings[name]; ok {
+				return true
+			}
+		}
+	}
+	for _, prefix := range clusterSettingsDynamicPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	// the affix settings, e.g. `xpack.monitoring.exporters.<name>.type`, are not listed with their names,
+	// so only the names sharing their namespace with a listed setting are known to be wrong, the others are validated by Elasticsearch on apply
+	namespace := name[:strings.LastIndex(name, ".")+1]
+	for _, category := range []string{"defaults", "persistent", "transient"} {
+		if settings, ok := clusterSettings[category].(map[string]interface{}); ok {
+			for key := range settings {
+				if strings.HasPrefix(key, namespace) {
+					return false
+				}
+			}
+		}
+	}
+	return namespace != ""
+}
+
+// Updates the configured boolean settings in place to the form accepted by Elasticsearch
+func normalizeSettings(configured, clusterSettings map[string]interface{}) {
+	for _, category := range []string{"persistent", "transient"} {
+		settings, ok := configured[category].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for name, v := range settings {
+			if clusterSettingType(name, v, clusterSettings) != settingTypeBool {
+				continue
+			}
+			if b, err := strconv.ParseBool(v.(string)); err == nil {
+				settings[name] = strconv.FormatBool(b)
+			}
+		}
+	}
+}
+
+// Infers the type of the setting from its default or current value, falling back to the configured one
+func clusterSettingType(name string, configured interface{}, clusterSettings map[string]interface{}) settingType {
+	for _, category := range []string{"defaults", "persistent", "transient"} {
+		if settings, ok := clusterSettings[category].(map[string]interface{}); ok {
+			if v, ok := settings[name]; ok {
+				return inferSettingType(v)
+			}
+		}
+	}
+	return inferSettingType(configured)
+}
+
+func inferSettingType(v interface{}) settingType {
+	switch t := v.(type) {
+	case []interface{}:
+		return settingTypeList
+	case string:
+		if t == "true" || t == "false" {
+			return settingTypeBool
+		}
+		if _, err := strconv.ParseFloat(t, 64); err == nil {
+			return settingTypeNumber
+		}
+		if _, err := utils.ParseTimeValue(t); err == nil {
+			return settingTypeTime
+		}
+		if _, err := utils.ParseByteSize(t); err == nil {
+			return settingTypeByteSize
+		}
+	}
+	return settingTypeString
+}
+
+func settingValuesEqual(t settingType, a, b interface{}) bool {
+	if t == settingTypeList {
+		return reflect.DeepEqual(settingValueList(a), settingValueList(b))
+	}
+
+	as, aok := a.(string)
+	bs, bok := b.(string)
+	if !aok || !bok {
+		return reflect.DeepEqual(a, b)
+	}
+	switch t {
+	case settingTypeBool:
+		av, aerr := strconv.ParseBool(as)
+		bv, berr := strconv.ParseBool(bs)
+		if aerr == nil && berr == nil {
+			return av == bv
+		}
+	case settingTypeNumber:
+		av, aerr := strconv.ParseFloat(as, 64)
+		bv, berr := strconv.ParseFloat(bs, 64)
+		if aerr == nil && berr == nil {
+			return av == bv
+		}
+	case settingTypeTime:
+		av, aerr := utils.ParseTimeValue(as)
+		bv, berr := utils.ParseTimeValue(bs)
+		if aerr == nil && berr == nil {
+			return av == bv
+		}
+	case settingTypeByteSize:
+		av, aerr := utils.ParseByteSize(as)
+		bv, berr := utils.ParseByteSize(bs)
+		if aerr == nil && berr == nil {
+			return av == bv
+		}
+	}
+	return as == bs
+}
+
+// Returns the list of values of the list setting, which can be also set as comma-separated string
+func settingValueList(v interface{}) []string {
+	result := make([]string, 0)
+	switch t := v.(type) {
+	case []interface{}:
+		for _, e := range t {
+			result = append(result, fmt.Sprintf("%v", e))
+		}
+	case string:
+		for _, e := range strings.Split(t, ",") {
+			if e = strings.TrimSpace(e); e != "" {
+				result = append(result, e)
+			}
+		}
+	}
+	return result
+}
+
+// Resets only the settings managed by the resource, and reports their previous values
 func resourceClusterSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClient(d, meta)
 	if diags.HasError() {
 		return diags
 	}
+	clusterSettings, diags := elasticsearch.GetSettings(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
 
 	configuredSettings, _ := getConfiguredSettings(d)
-	pSettings := make(map[string]interface{})
-	if v := configuredSettings["persistent"]; v != nil {
-		for k := range v.(map[string]interface{}) {
-			pSettings[k] = nil
-		}
-	}
-	tSettings := make(map[string]interface{})
-	if v := configuredSettings["transient"]; v != nil {
-		for k := range v.(map[string]interface{}) {
-			tSettings[k] = nil
+	settings := make(map[string]interface{})
+	previous := make([]string, 0)
+	for _, category := range []string{"persistent", "transient"} {
+		reset := make(map[string]interface{})
+		if v, ok := configuredSettings[category].(map[string]interface{}); ok {
+			current, _ := clusterSettings[category].(map[string]interface{})
+			for _, k := range utils.SortedKeys(v) {
+				reset[k] = nil
+				if cv, ok := current[k]; ok {
+					value, err := json.Marshal(cv)
+					if err != nil {
+						return diag.FromErr(err)
+					}
+					previous = append(previous, fmt.Sprintf("%s: %s = %s", category, k, value))
+				}
+			}
 		}
+		settings[category] = reset
 	}
 
-	settings := map[string]interface{}{
-		"persistent": pSettings,
-		"transient":  tSettings,
-	}
 	if diags := elasticsearch.PutSettings(ctx, client, settings); diags.HasError() {
 		return diags
 	}
 
+	if len(previous) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "The cluster settings are reset to their defaults.",
+			Detail:   fmt.Sprintf("The previous values of the reset settings:\n%s", strings.Join(previous, "\n")),
+		})
+	}
 	return diags
 }
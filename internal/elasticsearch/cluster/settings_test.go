@@ -3,6 +3,7 @@ package cluster_test
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
@@ -69,6 +70,60 @@ func TestAccResourceClusterSettings(t *testing.T) {
 	})
 }
 
+func TestAccResourceClusterSettingsTyped(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceClusterSettingsDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceClusterSettingsUnknown(),
+				ExpectError: regexp.MustCompile(`Unknown cluster setting "indices.recovery.unknown_setting"`),
+			},
+			{
+				Config: testAccResourceClusterSettingsTyped(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("elasticstack_elasticsearch_cluster_settings.test", "persistent.0.setting.*",
+						map[string]string{
+							"name":  "cluster.routing.allocation.disk.threshold_enabled",
+							"value": "TRUE",
+						}),
+					resource.TestCheckTypeSetElemNestedAttrs("elasticstack_elasticsearch_cluster_settings.test", "persistent.0.setting.*",
+						map[string]string{
+							"name":  "indices.lifecycle.poll_interval",
+							"value": "600s",
+						}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceClusterSettingsAffix(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceClusterSettingsDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceClusterSettingsAffix(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("elasticstack_elasticsearch_cluster_settings.test", "persistent.0.setting.*",
+						map[string]string{
+							"name":  "xpack.monitoring.exporters.test_exporter.type",
+							"value": "local",
+						}),
+					resource.TestCheckTypeSetElemNestedAttrs("elasticstack_elasticsearch_cluster_settings.test", "persistent.0.setting.*",
+						map[string]string{
+							"name":  "xpack.monitoring.exporters.test_exporter.enabled",
+							"value": "false",
+						}),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourceClusterSettingsCreate() string {
 	return `
 provider "elasticstack" {
@@ -130,6 +185,65 @@ resource "elasticstack_elasticsearch_cluster_settings" "test" {
 `
 }
 
+func testAccResourceClusterSettingsUnknown() string {
+	return `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_cluster_settings" "test" {
+  persistent {
+    setting {
+      name  = "indices.recovery.unknown_setting"
+      value = "50mb"
+    }
+  }
+}
+`
+}
+
+func testAccResourceClusterSettingsAffix() string {
+	return `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_cluster_settings" "test" {
+  persistent {
+    setting {
+      name  = "xpack.monitoring.exporters.test_exporter.type"
+      value = "local"
+    }
+    setting {
+      name  = "xpack.monitoring.exporters.test_exporter.enabled"
+      value = "false"
+    }
+  }
+}
+`
+}
+
+func testAccResourceClusterSettingsTyped() string {
+	return `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_cluster_settings" "test" {
+  persistent {
+    setting {
+      name  = "cluster.routing.allocation.disk.threshold_enabled"
+      value = "TRUE"
+    }
+    setting {
+      name  = "indices.lifecycle.poll_interval"
+      value = "600s"
+    }
+  }
+}
+`
+}
+
 func checkResourceClusterSettingsDestroy(s *terraform.State) error {
 	client, err := clients.NewAcceptanceTestingClient()
 	if err != nil {
@@ -141,6 +255,9 @@ func checkResourceClusterSettingsDestroy(s *terraform.State) error {
 		"indices.recovery.max_bytes_per_sec",
 		"indices.breaker.total.limit",
 		"xpack.security.audit.logfile.events.include",
+		"cluster.routing.allocation.disk.threshold_enabled",
+		"xpack.monitoring.exporters.test_exporter.type",
+		"xpack.monitoring.exporters.test_exporter.enabled",
 	}
 
 	for _, rs := range s.RootModule().Resources {
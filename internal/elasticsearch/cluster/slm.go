@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -134,6 +136,62 @@ func ResourceSlm() *schema.Resource {
 			Type:        schema.TypeString,
 			Required:    true,
 		},
+		"execute_on_create": {
+			Description: "If `true`, take a snapshot according to the policy right after the policy is created, and wait until the snapshot succeeds, up to the create timeout. The creation fails, if the snapshot is partial or fails.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"last_success": {
+			Description: "The last snapshot successfully taken by the policy.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: slmInvocationSchema(),
+			},
+		},
+		"last_failure": {
+			Description: "The last snapshot the policy failed to take.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: slmInvocationSchema(),
+			},
+		},
+		"next_execution": {
+			Description: "The time of the next scheduled execution of the policy.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"stats": {
+			Description: "The statistics of the snapshots taken and deleted by the policy.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"snapshots_taken": {
+						Description: "The number of the snapshots taken by the policy.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"snapshots_failed": {
+						Description: "The number of the snapshots the policy failed to take.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"snapshots_deleted": {
+						Description: "The number of the snapshots deleted by the retention of the policy.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"snapshot_deletion_failures": {
+						Description: "The number of the snapshots the retention of the policy failed to delete.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+				},
+			},
+		},
 	}
 
 	utils.AddConnectionSchema(slmSchema)
@@ -147,13 +205,42 @@ func ResourceSlm() *schema.Resource {
 		DeleteContext: resourceSlmDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				if err := d.Set("execute_on_create", false); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: slmSchema,
 	}
 }
 
+func slmInvocationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"snapshot_name": {
+			Description: "The name of the snapshot.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"time": {
+			Description: "The time of the policy execution.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"details": {
+			Description: "The details of the failure.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
 func resourceSlmPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClient(d, meta)
 	if diags.HasError() {
@@ -233,9 +320,55 @@ func resourceSlmPut(ctx context.Context, d *schema.ResourceData, meta interface{
 		return diags
 	}
 	d.SetId(id.String())
+
+	if d.IsNewResource() && d.Get("execute_on_create").(bool) {
+		snapshotName, diags := elasticsearch.ExecuteSlm(ctx, client, slmId)
+		if diags.HasError() {
+			return diags
+		}
+		if diags := waitForSnapshot(ctx, client, slm.Repository, snapshotName, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+			return diags
+		}
+		// the partial snapshot is completed, but the policy records it as the failure
+		snapshot, diags := elasticsearch.GetSnapshot(ctx, client, slm.Repository, snapshotName)
+		if diags.HasError() {
+			return diags
+		}
+		if snapshot != nil && snapshot.State == snapshotStatePartial {
+			return diag.Errorf("snapshot %s taken on create is partial, %d of %d shards failed", snapshotName, snapshot.Shards.Failed, snapshot.Shards.Total)
+		}
+		if diags := waitForSlmSuccess(ctx, client, slmId, snapshotName, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+			return diags
+		}
+	}
+
 	return resourceSlmRead(ctx, d, meta)
 }
 
+// The policy records the completed snapshot asynchronously, so it is polled until its last success or last failure reports the snapshot
+func waitForSlmSuccess(ctx context.Context, client *clients.ApiClient, slmId, snapshotName string, timeout time.Duration) diag.Diagnostics {
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		slmInfo, diags := elasticsearch.GetSlm(ctx, client, slmId)
+		if diags.HasError() {
+			return resource.NonRetryableError(fmt.Errorf("unable to get the snapshot lifecycle policy %s: %v", slmId, diags))
+		}
+		if slmInfo == nil {
+			return resource.NonRetryableError(fmt.Errorf("snapshot lifecycle policy %s is not found", slmId))
+		}
+		if slmInfo.LastFailure != nil && slmInfo.LastFailure.SnapshotName == snapshotName {
+			return resource.NonRetryableError(fmt.Errorf("snapshot %s failed: %s", snapshotName, slmInfo.LastFailure.Details))
+		}
+		if slmInfo.LastSuccess == nil || slmInfo.LastSuccess.SnapshotName != snapshotName {
+			return resource.RetryableError(fmt.Errorf("snapshot %s is not recorded by the policy %s yet", snapshotName, slmId))
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
 func resourceSlmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClient(d, meta)
 	if diags.HasError() {
@@ -246,8 +379,8 @@ func resourceSlmRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return diags
 	}
 
-	slm, diags := elasticsearch.GetSlm(ctx, client, id.ResourceId)
-	if slm == nil && diags == nil {
+	slmInfo, diags := elasticsearch.GetSlm(ctx, client, id.ResourceId)
+	if slmInfo == nil && diags == nil {
 		tflog.Warn(ctx, fmt.Sprintf(`SLM policy "%s" not found, removing from state`, id.ResourceId))
 		d.SetId("")
 		return diags
@@ -255,6 +388,7 @@ func resourceSlmRead(ctx context.Context, d *schema.ResourceData, meta interface
 	if diags.HasError() {
 		return diags
 	}
+	slm := slmInfo.Policy
 
 	if err := d.Set("snapshot_name", slm.Name); err != nil {
 		return diag.FromErr(err)
@@ -322,9 +456,52 @@ func resourceSlmRead(ctx context.Context, d *schema.ResourceData, meta interface
 		}
 	}
 
+	if err := d.Set("last_success", flattenSlmInvocation(slmInfo.LastSuccess)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("last_failure", flattenSlmInvocation(slmInfo.LastFailure)); err != nil {
+		return diag.FromErr(err)
+	}
+	nextExecution := ""
+	if slmInfo.NextExecutionMillis > 0 {
+		nextExecution = formatEpochMillis(slmInfo.NextExecutionMillis)
+	}
+	if err := d.Set("next_execution", nextExecution); err != nil {
+		return diag.FromErr(err)
+	}
+	stats := make([]interface{}, 0)
+	if slmInfo.Stats != nil {
+		stats = append(stats, map[string]interface{}{
+			"snapshots_taken":            slmInfo.Stats.SnapshotsTaken,
+			"snapshots_failed":           slmInfo.Stats.SnapshotsFailed,
+			"snapshots_deleted":          slmInfo.Stats.SnapshotsDeleted,
+			"snapshot_deletion_failures": slmInfo.Stats.SnapshotDeletionFailures,
+		})
+	}
+	if err := d.Set("stats", stats); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
+func flattenSlmInvocation(invocation *models.SnapshotPolicyInvocation) []interface{} {
+	if invocation == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"snapshot_name": invocation.SnapshotName,
+			"time":          formatEpochMillis(invocation.Time),
+			"details":       invocation.Details,
+		},
+	}
+}
+
+func formatEpochMillis(millis int64) string {
+	return time.UnixMilli(millis).UTC().Format(time.RFC3339)
+}
+
 func resourceSlmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClient(d, meta)
 	if diags.HasError() {
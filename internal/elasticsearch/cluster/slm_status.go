@@ -0,0 +1,99 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceSlmStatus() *schema.Resource {
+	statusSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"running": {
+			Description: "If `true`, start the snapshot lifecycle management, otherwise stop it. Stopping does not interrupt the snapshots in progress.",
+			Type:        schema.TypeBool,
+			Required:    true,
+		},
+		"operation_mode": {
+			Description: "The operation mode of the snapshot lifecycle management: `RUNNING`, `STOPPING` or `STOPPED`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(statusSchema)
+
+	return &schema.Resource{
+		Description: "Starts or stops the snapshot lifecycle management cluster-wide. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/slm-api-start.html and https://www.elastic.co/guide/en/elasticsearch/reference/current/slm-api-stop.html",
+
+		CreateContext: resourceSlmStatusPut,
+		UpdateContext: resourceSlmStatusPut,
+		ReadContext:   resourceSlmStatusRead,
+		DeleteContext: resourceSlmStatusDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: statusSchema,
+	}
+}
+
+func resourceSlmStatusPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	id, diags := client.ID(ctx, "slm-status")
+	if diags.HasError() {
+		return diags
+	}
+
+	if d.Get("running").(bool) {
+		diags = elasticsearch.StartSlm(ctx, client)
+	} else {
+		diags = elasticsearch.StopSlm(ctx, client)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.String())
+	return resourceSlmStatusRead(ctx, d, meta)
+}
+
+func resourceSlmStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	mode, diags := elasticsearch.GetSlmStatus(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+	if err := d.Set("operation_mode", mode); err != nil {
+		return diag.FromErr(err)
+	}
+	// SLM is stopping until the running jobs complete, it is considered stopped already
+	if err := d.Set("running", mode == "RUNNING"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// Only removes the resource from the state, the snapshot lifecycle management is kept in its current mode
+func resourceSlmStatusDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Info(ctx, "The snapshot lifecycle management is kept in its current operation mode")
+	return nil
+}
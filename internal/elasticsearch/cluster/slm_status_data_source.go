@@ -0,0 +1,58 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSlmStatus() *schema.Resource {
+	statusSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"operation_mode": {
+			Description: "The operation mode of the snapshot lifecycle management: `RUNNING`, `STOPPING` or `STOPPED`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(statusSchema)
+
+	return &schema.Resource{
+		Description: "Retrieves the status of the snapshot lifecycle management. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/slm-api-get-status.html",
+
+		ReadContext: dataSourceSlmStatusRead,
+
+		Schema: statusSchema,
+	}
+}
+
+func dataSourceSlmStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	id, diags := client.ID(ctx, "slm-status")
+	if diags.HasError() {
+		return diags
+	}
+
+	mode, diags := elasticsearch.GetSlmStatus(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+	if err := d.Set("operation_mode", mode); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
@@ -0,0 +1,86 @@
+package cluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceSlmStatus(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkSlmStatusDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlmStatus(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_slm_status.test", "running", "false"),
+				),
+			},
+			{
+				Config: testAccSlmStatus(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_slm_status.test", "running", "true"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_slm_status.test", "operation_mode", "RUNNING"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSlmStatus(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSlmStatus,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_slm_status.test", "operation_mode"),
+				),
+			},
+		},
+	})
+}
+
+// The destroy keeps the operation mode of SLM, so it is started again not to leave it stopped for the other tests, when a step fails
+func checkSlmStatusDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+	res, err := client.GetESClient().SlmStart()
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return fmt.Errorf("Unable to start SLM: %s", res.String())
+	}
+	return nil
+}
+
+func testAccSlmStatus(running bool) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_slm_status" "test" {
+  running = %t
+}
+`, running)
+}
+
+const testAccDataSourceSlmStatus = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_slm_status" "test" {}
+`
@@ -45,6 +45,58 @@ func TestAccResourceSLM(t *testing.T) {
 	})
 }
 
+func TestAccResourceSLMExecuteOnCreate(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkSlmDestroy(name),
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlmExecuteOnCreate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "execute_on_create", "true"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "last_success.#", "1"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "last_success.0.snapshot_name"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "last_success.0.time"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "last_failure.#", "0"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "next_execution"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "stats.0.snapshots_taken", "1"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_lifecycle.test_slm", "stats.0.snapshots_failed", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlmExecuteOnCreate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "repo" {
+  name = "%s-repo"
+
+  fs {
+    location = "/tmp/snapshots"
+  }
+}
+
+resource "elasticstack_elasticsearch_snapshot_lifecycle" "test_slm" {
+  name = "%s"
+
+  schedule      = "0 30 1 * * ?"
+  snapshot_name = "<%s-snap-{now/d}>"
+  repository    = elasticstack_elasticsearch_snapshot_repository.repo.name
+
+  include_global_state = false
+  execute_on_create    = true
+}
+	`, name, name, name)
+}
+
 func testAccSlmCreate(name string) string {
 	return fmt.Sprintf(`
 provider "elasticstack" {
@@ -0,0 +1,475 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	snapshotStateInProgress = "IN_PROGRESS"
+	snapshotStatePartial    = "PARTIAL"
+)
+
+func ResourceSnapshot() *schema.Resource {
+	snapshotSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"repository": {
+			Description: "Name of the repository, where the snapshot is stored.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "Name of the snapshot. Must be unique within the repository.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringIsNotWhiteSpace,
+				validation.StringMatch(regexp.MustCompile(`^[^A-Z\s\\/*?"<>|,#:]+$`), "must be lowercase and must not contain whitespaces or any of \\, /, *, ?, \", <, >, |, ,, #, : characters"),
+			),
+		},
+		"indices": {
+			Description: "Data streams and indices to include in the snapshot. Supports the wildcard patterns. By default, all regular data streams and indices are included.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"feature_states": {
+			Description: "Feature states to include in the snapshot. Use `none` to exclude all the feature states.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"include_global_state": {
+			Description: "If `true`, include the cluster state in the snapshot.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     true,
+		},
+		"partial": {
+			Description: "If `false`, the entire snapshot will fail if one or more indices included in the snapshot do not have all primary shards available. It is not returned by Elasticsearch, so it is not imported.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+		},
+		"metadata": {
+			Description:      "Attaches arbitrary metadata to the snapshot.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"wait_for_completion": {
+			Description: "If `true`, wait until the snapshot is completed, up to the create timeout. If the snapshot fails, the resource creation fails as well.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"delete_on_destroy": {
+			Description: "If `true`, delete the snapshot from the repository when the resource is destroyed. Otherwise, the snapshot is only removed from the Terraform state.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"uuid": {
+			Description: "The UUID of the snapshot.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"state": {
+			Description: "The state of the snapshot: `IN_PROGRESS`, `SUCCESS`, `PARTIAL`, `FAILED` or `INCOMPATIBLE`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"start_time": {
+			Description: "The time, when the snapshot process started.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"end_time": {
+			Description: "The time, when the snapshot process completed.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"duration_in_millis": {
+			Description: "The time in milliseconds it took for the snapshot process to complete.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"shards": {
+			Description: "The statistics of the shards included in the snapshot.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"total": {
+						Description: "The total number of the shards included in the snapshot.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"successful": {
+						Description: "The number of the shards successfully included in the snapshot.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"failed": {
+						Description: "The number of the shards failed to be included in the snapshot.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(snapshotSchema)
+
+	return &schema.Resource{
+		Description: "Creates a snapshot of the cluster or the selected data streams and indices. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/create-snapshot-api.html",
+
+		CreateContext: resourceSnapshotCreate,
+		UpdateContext: resourceSnapshotUpdate,
+		ReadContext:   resourceSnapshotRead,
+		DeleteContext: resourceSnapshotDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSnapshotImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: snapshotSchema,
+	}
+}
+
+func snapshotFromResourceID(resourceID string) (string, string, diag.Diagnostics) {
+	sep := strings.LastIndex(resourceID, ":")
+	if sep <= 0 || sep == len(resourceID)-1 {
+		return "", "", diag.Errorf(`Wrong snapshot ID "%s", it must have the following format: <cluster_uuid>/<repository>:<snapshot>`, resourceID)
+	}
+	return resourceID[:sep], resourceID[sep+1:], nil
+}
+
+// The imported snapshot gets the data streams, indices and feature states it contains, `partial` is not returned by Elasticsearch and keeps its default
+func resourceSnapshotImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("wait_for_completion", true); err != nil {
+		return nil, err
+	}
+	if err := d.Set("delete_on_destroy", false); err != nil {
+		return nil, err
+	}
+
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unable to create the Elasticsearch client: %v", diags)
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return nil, fmt.Errorf("%v", diags)
+	}
+	repository, name, diags := snapshotFromResourceID(compId.ResourceId)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%v", diags)
+	}
+	snapshot, diags := elasticsearch.GetSnapshot(ctx, client, repository, name)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unable to get the snapshot %s: %v", name, diags)
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("snapshot %s is not found in the repository %s", name, repository)
+	}
+	if err := d.Set("indices", snapshotIndices(snapshot)); err != nil {
+		return nil, err
+	}
+	if err := d.Set("feature_states", snapshotFeatureStates(snapshot)); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	snapshot := models.Snapshot{
+		Name:       d.Get("name").(string),
+		Repository: d.Get("repository").(string),
+		Indices:    utils.ExpandStringSet(d.Get("indices").(*schema.Set)),
+	}
+	id, diags := client.ID(ctx, fmt.Sprintf("%s:%s", snapshot.Repository, snapshot.Name))
+	if diags.HasError() {
+		return diags
+	}
+
+	if v, ok := d.GetOk("feature_states"); ok {
+		snapshot.FeatureStates = utils.ExpandStringSet(v.(*schema.Set))
+	}
+	includeGlobalState := d.Get("include_global_state").(bool)
+	snapshot.IncludeGlobalState = &includeGlobalState
+	partial := d.Get("partial").(bool)
+	snapshot.Partial = &partial
+	if v, ok := d.GetOk("metadata"); ok {
+		metadata := make(map[string]interface{})
+		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&metadata); err != nil {
+			return diag.FromErr(err)
+		}
+		snapshot.Metadata = metadata
+	}
+
+	if diags := elasticsearch.CreateSnapshot(ctx, client, &snapshot); diags.HasError() {
+		return diags
+	}
+	d.SetId(id.String())
+
+	if d.Get("wait_for_completion").(bool) {
+		if diags := waitForSnapshot(ctx, client, snapshot.Repository, snapshot.Name, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceSnapshotRead(ctx, d, meta)
+}
+
+// Polls the snapshot until it is not in progress anymore, and fails if the snapshot failed
+func waitForSnapshot(ctx context.Context, client *clients.ApiClient, repository, name string, timeout time.Duration) diag.Diagnostics {
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		snapshot, diags := elasticsearch.GetSnapshot(ctx, client, repository, name)
+		if diags.HasError() {
+			return resource.NonRetryableError(fmt.Errorf("unable to get the snapshot %s: %v", name, diags))
+		}
+		if snapshot == nil {
+			return resource.NonRetryableError(fmt.Errorf("snapshot %s is not found in the repository %s", name, repository))
+		}
+		switch snapshot.State {
+		case snapshotStateInProgress:
+			return resource.RetryableError(fmt.Errorf("snapshot %s is still in progress", name))
+		case "FAILED":
+			return resource.NonRetryableError(fmt.Errorf("snapshot %s failed: %s", name, snapshot.Reason))
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// Only the behaviour of the resource can be changed, the snapshot itself is immutable
+func resourceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceSnapshotRead(ctx, d, meta)
+}
+
+func resourceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	repository, name, diags := snapshotFromResourceID(compId.ResourceId)
+	if diags.HasError() {
+		return diags
+	}
+
+	snapshot, diags := elasticsearch.GetSnapshot(ctx, client, repository, name)
+	if snapshot == nil && diags == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`Snapshot "%s" not found in the repository "%s", removing from state`, name, repository))
+		d.SetId("")
+		return diags
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("repository", repository); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", snapshot.Snapshot); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("include_global_state", snapshot.IncludeGlobalState); err != nil {
+		return diag.FromErr(err)
+	}
+	// the configured patterns are kept as long as they cover the contents of the snapshot
+	if indices := snapshotIndices(snapshot); !indicesCovered(utils.ExpandStringSet(d.Get("indices").(*schema.Set)), indices) {
+		if err := d.Set("indices", indices); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if featureStates := snapshotFeatureStates(snapshot); !featureStatesCovered(utils.ExpandStringSet(d.Get("feature_states").(*schema.Set)), featureStates) {
+		if err := d.Set("feature_states", featureStates); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	metadata := snapshot.Metadata
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+	metadataBytes, err := json.Marshal(metadata)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metadata", string(metadataBytes)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("uuid", snapshot.Uuid); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("state", snapshot.State); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("start_time", snapshot.StartTime); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("end_time", snapshot.EndTime); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("duration_in_millis", snapshot.DurationInMillis); err != nil {
+		return diag.FromErr(err)
+	}
+	shards := []interface{}{
+		map[string]interface{}{
+			"total":      snapshot.Shards.Total,
+			"successful": snapshot.Shards.Successful,
+			"failed":     snapshot.Shards.Failed,
+		},
+	}
+	if err := d.Set("shards", shards); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// Returns the data streams and the regular indices of the snapshot, without the backing indices of the data streams and the indices of the feature states
+func snapshotIndices(snapshot *models.SnapshotInfo) []string {
+	excluded := make(map[string]bool)
+	for _, featureState := range snapshot.FeatureStates {
+		for _, index := range featureState.Indices {
+			excluded[index] = true
+		}
+	}
+	result := append(make([]string, 0), snapshot.DataStreams...)
+	for _, index := range snapshot.Indices {
+		if excluded[index] || isBackingIndex(index, snapshot.DataStreams) {
+			continue
+		}
+		result = append(result, index)
+	}
+	return result
+}
+
+func isBackingIndex(index string, dataStreams []string) bool {
+	for _, dataStream := range dataStreams {
+		if strings.HasPrefix(index, ".ds-"+dataStream+"-") || strings.HasPrefix(index, ".fs-"+dataStream+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+// Reports whether each of the data streams and indices of the snapshot matches the configured patterns, no patterns include everything
+func indicesCovered(patterns, indices []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	included := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		if pattern == "_all" {
+			return true
+		}
+		if !strings.HasPrefix(pattern, "-") {
+			included = append(included, pattern)
+		}
+	}
+	for _, index := range indices {
+		if !matchesAnyPattern(index, included) {
+			return false
+		}
+	}
+	return true
+}
+
+func snapshotFeatureStates(snapshot *models.SnapshotInfo) []string {
+	result := make([]string, len(snapshot.FeatureStates))
+	for i, featureState := range snapshot.FeatureStates {
+		result[i] = featureState.FeatureName
+	}
+	return result
+}
+
+// Reports whether the configured feature states match the ones of the snapshot, no feature states include the default ones
+func featureStatesCovered(configured, featureStates []string) bool {
+	if len(configured) == 0 {
+		return true
+	}
+	if len(configured) == 1 && configured[0] == "none" {
+		return len(featureStates) == 0
+	}
+	if len(configured) != len(featureStates) {
+		return false
+	}
+	for _, featureState := range featureStates {
+		if !contains(configured, featureState) {
+			return false
+		}
+	}
+	return true
+}
+
+func resourceSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	repository, name, diags := snapshotFromResourceID(compId.ResourceId)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !d.Get("delete_on_destroy").(bool) {
+		tflog.Info(ctx, fmt.Sprintf(`Snapshot "%s" is kept in the repository "%s", because "delete_on_destroy" is disabled`, name, repository))
+		return diags
+	}
+
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	return elasticsearch.DeleteSnapshot(ctx, client, repository, name)
+}
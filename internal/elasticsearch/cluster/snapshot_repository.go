@@ -3,20 +3,28 @@ package cluster
 import (
 	"context"
 	"fmt"
-	"reflect"
 	"regexp"
 	"strconv"
+	"time"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var (
+	snapshotRepositoryTypes               = []string{"fs", "url", "gcs", "azure", "s3", "hdfs", "source"}
+	snapshotRepositorySourceDelegateTypes = []string{"fs", "gcs", "azure", "s3", "hdfs"}
+
+	SnapshotRepositoryAnalyzeMinVersion = version.Must(version.NewVersion("7.12.0")) // Repository analysis is available since 7.12
+)
+
 func ResourceSnapshotRepository() *schema.Resource {
 	commonStdSettings := map[string]*schema.Schema{
 		"max_number_of_snapshots": {
@@ -203,6 +211,30 @@ func ResourceSnapshotRepository() *schema.Resource {
 		},
 	}
 
+	sourceDelegateKeys := make([]string, len(snapshotRepositorySourceDelegateTypes))
+	for i, dt := range snapshotRepositorySourceDelegateTypes {
+		sourceDelegateKeys[i] = fmt.Sprintf("source.0.%s", dt)
+	}
+	sourceDelegates := make(map[string]*schema.Schema, len(snapshotRepositorySourceDelegateTypes))
+	for t, settings := range map[string]map[string]*schema.Schema{
+		"fs":    utils.MergeSchemaMaps(commonSettings, commonStdSettings, fsSettings),
+		"gcs":   utils.MergeSchemaMaps(commonSettings, gcsSettings),
+		"azure": utils.MergeSchemaMaps(commonSettings, azureSettings),
+		"s3":    utils.MergeSchemaMaps(commonSettings, s3Settings),
+		"hdfs":  utils.MergeSchemaMaps(commonSettings, hdfsSettings),
+	} {
+		sourceDelegates[t] = &schema.Schema{
+			Description:  fmt.Sprintf("The `%s` repository, to which the source-only repository delegates the storage of the snapshots.", t),
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: sourceDelegateKeys,
+			Elem: &schema.Resource{
+				Schema: settings,
+			},
+		}
+	}
+
 	// --
 
 	snapRepoSchema := map[string]*schema.Schema{
@@ -229,8 +261,8 @@ func ResourceSnapshotRepository() *schema.Resource {
 			ForceNew:      true,
 			Optional:      true,
 			MaxItems:      1,
-			ConflictsWith: []string{"url", "gcs", "azure", "s3", "hdfs"},
-			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs"},
+			ConflictsWith: []string{"url", "gcs", "azure", "s3", "hdfs", "source"},
+			ExactlyOneOf:  snapshotRepositoryTypes,
 			Elem: &schema.Resource{
 				Schema: utils.MergeSchemaMaps(commonSettings, commonStdSettings, fsSettings),
 			},
@@ -241,8 +273,8 @@ func ResourceSnapshotRepository() *schema.Resource {
 			ForceNew:      true,
 			Optional:      true,
 			MaxItems:      1,
-			ConflictsWith: []string{"fs", "gcs", "azure", "s3", "hdfs"},
-			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs"},
+			ConflictsWith: []string{"fs", "gcs", "azure", "s3", "hdfs", "source"},
+			ExactlyOneOf:  snapshotRepositoryTypes,
 			Elem: &schema.Resource{
 				Schema: utils.MergeSchemaMaps(commonSettings, commonStdSettings, urlSettings),
 			},
@@ -253,8 +285,8 @@ func ResourceSnapshotRepository() *schema.Resource {
 			ForceNew:      true,
 			Optional:      true,
 			MaxItems:      1,
-			ConflictsWith: []string{"fs", "s3", "azure", "hdfs", "url"},
-			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs"},
+			ConflictsWith: []string{"fs", "s3", "azure", "hdfs", "url", "source"},
+			ExactlyOneOf:  snapshotRepositoryTypes,
 			Elem: &schema.Resource{
 				Schema: utils.MergeSchemaMaps(commonSettings, gcsSettings),
 			},
@@ -265,8 +297,8 @@ func ResourceSnapshotRepository() *schema.Resource {
 			ForceNew:      true,
 			Optional:      true,
 			MaxItems:      1,
-			ConflictsWith: []string{"fs", "gcs", "url", "s3", "hdfs"},
-			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs"},
+			ConflictsWith: []string{"fs", "gcs", "url", "s3", "hdfs", "source"},
+			ExactlyOneOf:  snapshotRepositoryTypes,
 			Elem: &schema.Resource{
 				Schema: utils.MergeSchemaMaps(commonSettings, azureSettings),
 			},
@@ -277,8 +309,8 @@ func ResourceSnapshotRepository() *schema.Resource {
 			ForceNew:      true,
 			Optional:      true,
 			MaxItems:      1,
-			ConflictsWith: []string{"fs", "url", "gcs", "azure", "hdfs"},
-			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs"},
+			ConflictsWith: []string{"fs", "url", "gcs", "azure", "hdfs", "source"},
+			ExactlyOneOf:  snapshotRepositoryTypes,
 			Elem: &schema.Resource{
 				Schema: utils.MergeSchemaMaps(commonSettings, s3Settings),
 			},
@@ -289,12 +321,138 @@ func ResourceSnapshotRepository() *schema.Resource {
 			ForceNew:      true,
 			Optional:      true,
 			MaxItems:      1,
-			ConflictsWith: []string{"fs", "url", "gcs", "azure", "s3"},
-			ExactlyOneOf:  []string{"fs", "url", "gcs", "azure", "s3", "hdfs"},
+			ConflictsWith: []string{"fs", "url", "gcs", "azure", "s3", "source"},
+			ExactlyOneOf:  snapshotRepositoryTypes,
 			Elem: &schema.Resource{
 				Schema: utils.MergeSchemaMaps(commonSettings, hdfsSettings),
 			},
 		},
+		"source": {
+			Description:   "Source-only repository. The repository stores only the stored fields and the index metadata of the indices, and delegates the storage to the repository of the type defined by the nested block. See: https://www.elastic.co/guide/en/elasticsearch/reference/current/snapshots-source-only-repository.html",
+			Type:          schema.TypeList,
+			ForceNew:      true,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"fs", "url", "gcs", "azure", "s3", "hdfs"},
+			ExactlyOneOf:  snapshotRepositoryTypes,
+			Elem: &schema.Resource{
+				Schema: sourceDelegates,
+			},
+		},
+		"verified_nodes": {
+			Description: "The nodes, on which the repository was verified, when `verify` is enabled.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The ID of the node.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "The name of the node.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"analyze": {
+			Description: "If set, run the analysis of the repository storage after the repository is registered or updated. The analysis writes and reads the blobs to the repository, and fails if the storage does not behave correctly. Supported from Elasticsearch version **7.12**. See: https://www.elastic.co/guide/en/elasticsearch/reference/current/repo-analysis-api.html",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"blob_count": {
+						Description:  "The total number of the blobs to write to the repository during the analysis.",
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      100,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"max_blob_size": {
+						Description: "The upper limit for the size of the blobs written during the analysis.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "10mb",
+					},
+					"max_total_data_size": {
+						Description: "The upper limit for the total size of all the blobs written during the analysis.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "1gb",
+					},
+					"timeout": {
+						Description:  "The time to wait for the analysis to complete, e.g. `30s` or `5m`.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "30s",
+						ValidateFunc: utils.ValidateDuration,
+					},
+				},
+			},
+		},
+		"analysis": {
+			Description: "The summary of the last analysis of the repository storage.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"blob_path": {
+						Description: "The path in the repository, under which the blobs were written during the analysis.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"write_count": {
+						Description: "The number of the write operations performed during the analysis.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"write_total_size": {
+						Description: "The total size of all the blobs written during the analysis.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"write_total_elapsed": {
+						Description: "The total elapsed time spent on writing the blobs.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"read_count": {
+						Description: "The number of the read operations performed during the analysis.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"read_total_size": {
+						Description: "The total size of all the blobs read during the analysis.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"read_total_elapsed": {
+						Description: "The total elapsed time spent on reading the blobs.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"read_max_wait": {
+						Description: "The maximum time spent waiting for the first byte of any read request to be received.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"listing_elapsed": {
+						Description: "The time it took to list the blobs written during the analysis.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"delete_elapsed": {
+						Description: "The time it took to delete the blobs written during the analysis.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
 	}
 
 	utils.AddConnectionSchema(snapRepoSchema)
@@ -334,13 +492,21 @@ func resourceSnapRepoPut(ctx context.Context, d *schema.ResourceData, meta inter
 		snapRepo.Verify = v.(bool)
 	}
 
-	// find supported repository types and iterate over them
-	schemaTypes := ResourceSnapshotRepository().Schema
-	delete(schemaTypes, "elasticsearch_connection")
-	for t := range schemaTypes {
-		if v, ok := d.GetOk(t); ok && reflect.TypeOf(v).Kind() == reflect.Slice {
+	// find the configured repository type
+	for _, t := range snapshotRepositoryTypes {
+		if v, ok := d.GetOk(t); ok {
 			snapRepo.Type = t
-			expandFsSettings(v.([]interface{})[0].(map[string]interface{}), snapRepoSettings)
+			settings := v.([]interface{})[0].(map[string]interface{})
+			if t != "source" {
+				expandFsSettings(settings, snapRepoSettings)
+				continue
+			}
+			for _, dt := range snapshotRepositorySourceDelegateTypes {
+				if delegate := settings[dt].([]interface{}); len(delegate) > 0 {
+					snapRepoSettings["delegate_type"] = dt
+					expandFsSettings(delegate[0].(map[string]interface{}), snapRepoSettings)
+				}
+			}
 		}
 	}
 	snapRepo.Settings = snapRepoSettings
@@ -349,9 +515,71 @@ func resourceSnapRepoPut(ctx context.Context, d *schema.ResourceData, meta inter
 		return diags
 	}
 	d.SetId(id.String())
+
+	verifiedNodes := make([]interface{}, 0)
+	if snapRepo.Verify {
+		nodes, diags := elasticsearch.VerifySnapshotRepository(ctx, client, repoId)
+		if diags.HasError() {
+			return diags
+		}
+		for _, node := range nodes {
+			verifiedNodes = append(verifiedNodes, map[string]interface{}{
+				"id":   node.Id,
+				"name": node.Name,
+			})
+		}
+	}
+	if err := d.Set("verified_nodes", verifiedNodes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	analysis := make([]interface{}, 0)
+	if v, ok := d.GetOk("analyze"); ok {
+		result, diags := analyzeSnapRepo(ctx, client, repoId, v.([]interface{})[0].(map[string]interface{}))
+		if diags.HasError() {
+			return diags
+		}
+		analysis = append(analysis, map[string]interface{}{
+			"blob_path":           result.BlobPath,
+			"write_count":         result.Summary.Write.Count,
+			"write_total_size":    result.Summary.Write.TotalSize,
+			"write_total_elapsed": result.Summary.Write.TotalElapsed,
+			"read_count":          result.Summary.Read.Count,
+			"read_total_size":     result.Summary.Read.TotalSize,
+			"read_total_elapsed":  result.Summary.Read.TotalElapsed,
+			"read_max_wait":       result.Summary.Read.MaxWait,
+			"listing_elapsed":     result.ListingElapsed,
+			"delete_elapsed":      result.DeleteElapsed,
+		})
+	}
+	if err := d.Set("analysis", analysis); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceSnapRepoRead(ctx, d, meta)
 }
 
+func analyzeSnapRepo(ctx context.Context, client *clients.ApiClient, name string, analyze map[string]interface{}) (*models.SnapshotRepositoryAnalysisResult, diag.Diagnostics) {
+	serverVersion, diags := client.ServerVersion(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if serverVersion.LessThan(SnapshotRepositoryAnalyzeMinVersion) {
+		return nil, diag.Errorf("'analyze' is supported only for Elasticsearch v%s and above", SnapshotRepositoryAnalyzeMinVersion.String())
+	}
+
+	timeout, err := time.ParseDuration(analyze["timeout"].(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return elasticsearch.AnalyzeSnapshotRepository(ctx, client, name, &models.SnapshotRepositoryAnalysis{
+		BlobCount:        analyze["blob_count"].(int),
+		MaxBlobSize:      analyze["max_blob_size"].(string),
+		MaxTotalDataSize: analyze["max_total_data_size"].(string),
+		Timeout:          timeout,
+	})
+}
+
 func expandFsSettings(source, target map[string]interface{}) {
 	for k, v := range source {
 		if !utils.IsEmpty(v) {
@@ -393,6 +621,15 @@ func resourceSnapRepoRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	// get the schema of the Elem of the current repo type
 	schemaSettings := ResourceSnapshotRepository().Schema[currentRepo.Type].Elem.(*schema.Resource).Schema
+	delegateType := ""
+	if currentRepo.Type == "source" {
+		delegateType, _ = currentRepo.Settings["delegate_type"].(string)
+		delegateSchema, ok := schemaSettings[delegateType]
+		if !ok {
+			return diag.Errorf(`The delegate type "%s" of the source-only snapshot repository is not supported.`, delegateType)
+		}
+		schemaSettings = delegateSchema.Elem.(*schema.Resource).Schema
+	}
 
 	settings, err := flattenRepoSettings(currentRepo, schemaSettings)
 	if err != nil {
@@ -403,6 +640,11 @@ func resourceSnapRepoRead(ctx context.Context, d *schema.ResourceData, meta inte
 		})
 		return diags
 	}
+	if delegateType != "" {
+		settings = []interface{}{
+			map[string]interface{}{delegateType: settings},
+		}
+	}
 	if err := d.Set(currentRepo.Type, settings); err != nil {
 		return diag.FromErr(err)
 	}
@@ -267,19 +267,20 @@ func dataSourceSnapRepoRead(ctx context.Context, d *schema.ResourceData, meta in
 		return diags
 	}
 
-	// get the schema of the Elem of the current repo type
-	schemaSettings := DataSourceSnapshotRespository().Schema[currentRepo.Type].Elem.(*schema.Resource).Schema
-	settings, err := flattenRepoSettings(currentRepo, schemaSettings)
-	if err != nil {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Unable to parse snapshot repository settings.",
-			Detail:   fmt.Sprintf(`Unable to parse settings returned by ES API: %v`, err),
-		})
-		return diags
-	}
-	if err := d.Set(currentRepo.Type, settings); err != nil {
-		return diag.FromErr(err)
+	// get the schema of the Elem of the current repo type, the settings of the types without own block (e.g. source) are not exposed
+	if typeSchema, ok := DataSourceSnapshotRespository().Schema[currentRepo.Type]; ok {
+		settings, err := flattenRepoSettings(currentRepo, typeSchema.Elem.(*schema.Resource).Schema)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to parse snapshot repository settings.",
+				Detail:   fmt.Sprintf(`Unable to parse settings returned by ES API: %v`, err),
+			})
+			return diags
+		}
+		if err := d.Set(currentRepo.Type, settings); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	if err := d.Set("type", currentRepo.Type); err != nil {
@@ -6,6 +6,8 @@ import (
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/cluster"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -65,6 +67,50 @@ func TestAccResourceSnapRepoUrl(t *testing.T) {
 	})
 }
 
+func TestAccResourceSnapRepoSource(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkRepoDestroy(name),
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepoSourceCreate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_repository.test_source_repo", "name", name),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_repository.test_source_repo", "source.0.fs.0.location", "/tmp"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_repository.test_source_repo", "source.0.fs.0.compress", "true"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_snapshot_repository.test_source_repo", "verified_nodes.0.id"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_snapshot_repository.test_source_repo", "verified_nodes.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSnapRepoAnalyze(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkRepoDestroy(name),
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(cluster.SnapshotRepositoryAnalyzeMinVersion),
+				Config:   testAccRepoAnalyze(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot_repository.test_analyze_repo", "name", name),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_snapshot_repository.test_analyze_repo", "analysis.0.blob_path"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_snapshot_repository.test_analyze_repo", "analysis.0.write_count"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_snapshot_repository.test_analyze_repo", "analysis.0.read_count"),
+				),
+			},
+		},
+	})
+}
+
 func testAccRepoFsCreate(name string) string {
 	return fmt.Sprintf(`
 provider "elasticstack" {
@@ -99,6 +145,46 @@ resource "elasticstack_elasticsearch_snapshot_repository" "test_url_repo" {
 	`, name)
 }
 
+func testAccRepoSourceCreate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "test_source_repo" {
+  name = "%s"
+
+  source {
+    fs {
+      location = "/tmp"
+    }
+  }
+}
+	`, name)
+}
+
+func testAccRepoAnalyze(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "test_analyze_repo" {
+  name = "%s"
+
+  fs {
+    location = "/tmp"
+  }
+
+  analyze {
+    blob_count    = 10
+    max_blob_size = "1mb"
+    timeout       = "2m"
+  }
+}
+	`, name)
+}
+
 func checkRepoDestroy(name string) func(s *terraform.State) error {
 	return func(s *terraform.State) error {
 		client, err := clients.NewAcceptanceTestingClient()
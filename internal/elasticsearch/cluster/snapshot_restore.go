@@ -0,0 +1,244 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceSnapshotRestore() *schema.Resource {
+	restoreSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"repository": {
+			Description: "Name of the repository to restore the snapshot from.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"snapshot": {
+			Description: "Name of the snapshot to restore.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"indices": {
+			Description: "Data streams and indices to restore. Supports the wildcard patterns. By default, all regular data streams and indices in the snapshot are restored.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"include_aliases": {
+			Description: "If `true`, restore the aliases of the restored data streams and indices.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     true,
+		},
+		"include_global_state": {
+			Description: "If `true`, restore the cluster state, e.g. the persistent cluster settings, the templates and the pipelines, from the snapshot.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+		},
+		"partial": {
+			Description: "If `false`, the entire restore fails if one or more indices included in the snapshot do not have all primary shards available.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+		},
+		"rename_pattern": {
+			Description:  "Defines a rename pattern to apply to the restored data streams and indices. The data streams and indices matching the pattern are renamed using `rename_replacement`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			RequiredWith: []string{"rename_replacement"},
+		},
+		"rename_replacement": {
+			Description:  "Defines the rename replacement string, which may reference the groups of the `rename_pattern`, e.g. `restored-$1`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			RequiredWith: []string{"rename_pattern"},
+		},
+		"index_settings": {
+			Description:      "Index settings to add or change in the restored indices, including the backing indices.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"ignore_index_settings": {
+			Description: "Index settings not to restore from the snapshot.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"restored_indices": {
+			Description: "The indices restored from the snapshot.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"shards": {
+			Description: "The statistics of the restored shards.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"total": {
+						Description: "The total number of the restored shards.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"successful": {
+						Description: "The number of the successfully restored shards.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"failed": {
+						Description: "The number of the shards failed to be restored.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(restoreSchema)
+
+	return &schema.Resource{
+		Description: "Restores the snapshot and waits for the recovery of the restored indices. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/restore-snapshot-api.html",
+
+		CreateContext: resourceSnapshotRestoreCreate,
+		UpdateContext: resourceSnapshotRestoreUpdate,
+		ReadContext:   resourceSnapshotRestoreRead,
+		DeleteContext: resourceSnapshotRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: restoreSchema,
+	}
+}
+
+func resourceSnapshotRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	restore := models.SnapshotRestore{
+		Repository:          d.Get("repository").(string),
+		Snapshot:            d.Get("snapshot").(string),
+		Indices:             utils.ExpandStringSet(d.Get("indices").(*schema.Set)),
+		RenamePattern:       d.Get("rename_pattern").(string),
+		RenameReplacement:   d.Get("rename_replacement").(string),
+		IgnoreIndexSettings: utils.ExpandStringSet(d.Get("ignore_index_settings").(*schema.Set)),
+	}
+	id, diags := client.ID(ctx, fmt.Sprintf("%s:%s", restore.Repository, restore.Snapshot))
+	if diags.HasError() {
+		return diags
+	}
+
+	includeAliases := d.Get("include_aliases").(bool)
+	restore.IncludeAliases = &includeAliases
+	includeGlobalState := d.Get("include_global_state").(bool)
+	restore.IncludeGlobalState = &includeGlobalState
+	partial := d.Get("partial").(bool)
+	restore.Partial = &partial
+	if v, ok := d.GetOk("index_settings"); ok {
+		settings := make(map[string]interface{})
+		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&settings); err != nil {
+			return diag.FromErr(err)
+		}
+		restore.IndexSettings = settings
+	}
+
+	restoreCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	info, diags := elasticsearch.RestoreSnapshot(restoreCtx, client, &restore)
+	if diags.HasError() {
+		return diags
+	}
+	if info.Shards.Failed > 0 && !partial {
+		return diag.Errorf("Restore of the snapshot %s failed for %d out of %d shards", restore.Snapshot, info.Shards.Failed, info.Shards.Total)
+	}
+
+	if err := d.Set("restored_indices", info.Indices); err != nil {
+		return diag.FromErr(err)
+	}
+	shards := []interface{}{
+		map[string]interface{}{
+			"total":      info.Shards.Total,
+			"successful": info.Shards.Successful,
+			"failed":     info.Shards.Failed,
+		},
+	}
+	if err := d.Set("shards", shards); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return resourceSnapshotRestoreRead(ctx, d, meta)
+}
+
+// Only the connection settings can be changed, the restore itself is not repeated
+func resourceSnapshotRestoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceSnapshotRestoreRead(ctx, d, meta)
+}
+
+// The restore is considered gone, when any of the restored indices does not exist anymore, so the next apply restores the snapshot again
+func resourceSnapshotRestoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	indices := utils.ExpandStringSet(d.Get("restored_indices").(*schema.Set))
+	if len(indices) == 0 {
+		return diags
+	}
+	exist, diags := elasticsearch.IndicesExist(ctx, client, indices)
+	if diags.HasError() {
+		return diags
+	}
+	if !exist {
+		tflog.Warn(ctx, fmt.Sprintf(`Some of the indices restored from the snapshot "%s" not found, removing from state`, d.Get("snapshot").(string)))
+		d.SetId("")
+	}
+	return diags
+}
+
+// Only removes the restore from the state, the restored indices are kept in the cluster
+func resourceSnapshotRestoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf(`The indices restored from the snapshot "%s" are kept in the cluster`, d.Get("snapshot").(string)))
+	return nil
+}
@@ -0,0 +1,107 @@
+package cluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceSnapshot(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkSnapshotDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotCreate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "name", fmt.Sprintf("%s-snap", name)),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "repository", fmt.Sprintf("%s-repo", name)),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "state", "SUCCESS"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "include_global_state", "false"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_snapshot.test", "indices.*", fmt.Sprintf("%s-index", name)),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "feature_states.#", "1"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "metadata", `{"taken_by":"terraform"}`),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_snapshot.test", "shards.0.failed", "0"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_snapshot.test", "uuid"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_snapshot.test", "start_time"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_snapshot.test", "end_time"),
+				),
+			},
+			{
+				ResourceName:      "elasticstack_elasticsearch_snapshot.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// `partial` is not returned by Elasticsearch, and `none` feature states are imported as no feature states
+				ImportStateVerifyIgnore: []string{"feature_states", "partial", "delete_on_destroy"},
+			},
+		},
+	})
+}
+
+func testAccSnapshotCreate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name                = "%s-index"
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "repo" {
+  name = "%s-repo"
+
+  fs {
+    location = "/tmp/snapshots"
+  }
+}
+
+resource "elasticstack_elasticsearch_snapshot" "test" {
+  repository = elasticstack_elasticsearch_snapshot_repository.repo.name
+  name       = "%s-snap"
+
+  indices              = [elasticstack_elasticsearch_index.test.name]
+  feature_states       = ["none"]
+  include_global_state = false
+  metadata = jsonencode({
+    taken_by = "terraform"
+  })
+
+  delete_on_destroy = true
+}
+	`, name, name, name)
+}
+
+func checkSnapshotDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_snapshot" {
+			continue
+		}
+
+		repository := rs.Primary.Attributes["repository"]
+		name := rs.Primary.Attributes["name"]
+		res, err := client.GetESClient().Snapshot.Get(repository, []string{name})
+		if err != nil {
+			return err
+		}
+
+		if res.StatusCode != 404 {
+			return fmt.Errorf("Snapshot (%s) still exists in the repository (%s)", name, repository)
+		}
+	}
+	return nil
+}
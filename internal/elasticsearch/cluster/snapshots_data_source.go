@@ -0,0 +1,181 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var SnapshotsSortMinVersion = version.Must(version.NewVersion("7.13.0")) // Sorting and paging of the snapshots is available since 7.13
+
+func DataSourceSnapshots() *schema.Resource {
+	snapshotsSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"repository": {
+			Description: "Name of the repository to list the snapshots from.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: "Name of the snapshots to list. Supports the wildcard patterns, and `_all` or `*` to list all the snapshots in the repository.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "*",
+		},
+		"sort": {
+			Description:  "The attribute to sort the snapshots by: `start_time`, `duration`, `name`, `index_count`, `repository`, `shard_count` or `failed_shard_count`. Supported from Elasticsearch version **7.13**",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"start_time", "duration", "name", "index_count", "repository", "shard_count", "failed_shard_count"}, false),
+		},
+		"order": {
+			Description:  "The sort order: `asc` or `desc`. Supported from Elasticsearch version **7.13**",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"asc", "desc"}, false),
+		},
+		"size": {
+			Description:  "Maximum number of snapshots to return. By default, all the matching snapshots are returned. Supported from Elasticsearch version **7.13**",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"snapshots": {
+			Description: "The list of the matching snapshots.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The name of the snapshot.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"uuid": {
+						Description: "The UUID of the snapshot.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"state": {
+						Description: "The state of the snapshot: `IN_PROGRESS`, `SUCCESS`, `PARTIAL`, `FAILED` or `INCOMPATIBLE`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"indices": {
+						Description: "The indices included in the snapshot.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"data_streams": {
+						Description: "The data streams included in the snapshot.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"include_global_state": {
+						Description: "Whether the cluster state is included in the snapshot.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+					"start_time": {
+						Description: "The time, when the snapshot process started.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"end_time": {
+						Description: "The time, when the snapshot process completed.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"duration_in_millis": {
+						Description: "The time in milliseconds it took for the snapshot process to complete.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(snapshotsSchema)
+
+	return &schema.Resource{
+		Description: "Lists the snapshots stored in the repository. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/get-snapshot-api.html",
+
+		ReadContext: dataSourceSnapshotsRead,
+
+		Schema: snapshotsSchema,
+	}
+}
+
+func dataSourceSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	query := models.SnapshotsQuery{
+		Repository: d.Get("repository").(string),
+		Name:       d.Get("name").(string),
+		Sort:       d.Get("sort").(string),
+		Order:      d.Get("order").(string),
+		Size:       d.Get("size").(int),
+	}
+	id, diags := client.ID(ctx, fmt.Sprintf("%s:%s", query.Repository, query.Name))
+	if diags.HasError() {
+		return diags
+	}
+
+	if query.Sort != "" || query.Order != "" || query.Size > 0 {
+		serverVersion, diags := client.ServerVersion(ctx)
+		if diags.HasError() {
+			return diags
+		}
+		if serverVersion.LessThan(SnapshotsSortMinVersion) {
+			return diag.Errorf("'sort', 'order' and 'size' are supported only for Elasticsearch v%s and above", SnapshotsSortMinVersion.String())
+		}
+	}
+
+	snapshots, diags := elasticsearch.GetSnapshots(ctx, client, &query)
+	if diags.HasError() {
+		return diags
+	}
+
+	result := make([]interface{}, len(snapshots))
+	for i, snapshot := range snapshots {
+		result[i] = map[string]interface{}{
+			"name":                 snapshot.Snapshot,
+			"uuid":                 snapshot.Uuid,
+			"state":                snapshot.State,
+			"indices":              snapshot.Indices,
+			"data_streams":         snapshot.DataStreams,
+			"include_global_state": snapshot.IncludeGlobalState,
+			"start_time":           snapshot.StartTime,
+			"end_time":             snapshot.EndTime,
+			"duration_in_millis":   snapshot.DurationInMillis,
+		}
+	}
+	if err := d.Set("snapshots", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
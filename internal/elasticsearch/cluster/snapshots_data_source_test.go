@@ -0,0 +1,66 @@
+package cluster_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSnapshots(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSnapshots(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshots.test", "snapshots.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshots.test", "snapshots.0.name", fmt.Sprintf("%s-snap", name)),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshots.test", "snapshots.0.state", "SUCCESS"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshots.test", "snapshots.0.indices.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_snapshots.test", "snapshots.0.indices.0", fmt.Sprintf("%s-index", name)),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_snapshots.test", "snapshots.0.start_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSnapshots(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name                = "%s-index"
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "repo" {
+  name = "%s-repo"
+
+  fs {
+    location = "/tmp/snapshots"
+  }
+}
+
+resource "elasticstack_elasticsearch_snapshot" "test" {
+  repository           = elasticstack_elasticsearch_snapshot_repository.repo.name
+  name                 = "%s-snap"
+  indices              = [elasticstack_elasticsearch_index.test.name]
+  include_global_state = false
+  delete_on_destroy    = true
+}
+
+data "elasticstack_elasticsearch_snapshots" "test" {
+  repository = elasticstack_elasticsearch_snapshot.test.repository
+  name       = "%s-*"
+}
+	`, name, name, name, name)
+}
@@ -0,0 +1,262 @@
+package index
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceAlias() *schema.Resource {
+	aliasSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the alias.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"is_hidden": {
+			Description: "If true, the alias is hidden. All indices for the alias must have the same `is_hidden` value.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"index": {
+			Description: "Indices the alias points to, together with the alias properties for the each index.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "Name of the index.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"filter": {
+						Description:  "Query used to limit documents the alias can access in this index.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "",
+						ValidateFunc: validation.StringIsJSON,
+					},
+					"index_routing": {
+						Description: "Value used to route indexing operations to a specific shard of this index.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "",
+					},
+					"search_routing": {
+						Description: "Value used to route search operations to a specific shard of this index.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "",
+					},
+					"is_write_index": {
+						Description: "If true, the index is the write index for the alias. Only one index can be the write index at a time.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(aliasSchema)
+
+	return &schema.Resource{
+		Description: "Manages an alias pointing to one or more indices. All the changes are applied atomically. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-aliases.html",
+
+		CreateContext: resourceAliasPut,
+		UpdateContext: resourceAliasPut,
+		ReadContext:   resourceAliasRead,
+		DeleteContext: resourceAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			writeIndices := make([]string, 0)
+			for _, i := range d.Get("index").(*schema.Set).List() {
+				index := i.(map[string]interface{})
+				if index["is_write_index"].(bool) {
+					writeIndices = append(writeIndices, index["name"].(string))
+				}
+			}
+			if len(writeIndices) > 1 {
+				sort.Strings(writeIndices)
+				return fmt.Errorf("only one index can be the write index for the alias, got: %v", writeIndices)
+			}
+			return nil
+		},
+
+		Schema: aliasSchema,
+	}
+}
+
+func resourceAliasPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	aliasName := d.Get("name").(string)
+	id, diags := client.ID(ctx, aliasName)
+	if diags.HasError() {
+		return diags
+	}
+
+	newIndices, diags := expandAliasIndices(d.Get("index").(*schema.Set), aliasName, d.Get("is_hidden").(bool))
+	if diags.HasError() {
+		return diags
+	}
+
+	actions := make([]models.IndexAliasAction, 0)
+	// remove the alias from the indices which are not part of the configuration anymore,
+	// those must be sent in the same request to make sure the alias is swapped atomically
+	if !d.IsNewResource() {
+		oldIndices, _ := d.GetChange("index")
+		for _, i := range oldIndices.(*schema.Set).List() {
+			indexName := i.(map[string]interface{})["name"].(string)
+			if _, ok := newIndices[indexName]; !ok {
+				actions = append(actions, models.IndexAliasAction{
+					Remove: &models.IndexAliasActionParams{Index: indexName, Alias: aliasName},
+				})
+			}
+		}
+	}
+	for indexName, alias := range newIndices {
+		actions = append(actions, models.IndexAliasAction{
+			Add: &models.IndexAliasActionParams{IndexAlias: alias, Index: indexName, Alias: aliasName},
+		})
+	}
+
+	if diags := elasticsearch.UpdateAliases(ctx, client, actions); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.String())
+	return resourceAliasRead(ctx, d, meta)
+}
+
+func expandAliasIndices(definedIndices *schema.Set, aliasName string, isHidden bool) (map[string]models.IndexAlias, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	indices := make(map[string]models.IndexAlias, definedIndices.Len())
+	for _, i := range definedIndices.List() {
+		index := i.(map[string]interface{})
+		indexName := index["name"].(string)
+		if _, ok := indices[indexName]; ok {
+			return nil, diag.Errorf(`index "%s" is defined more than once for the alias "%s"`, indexName, aliasName)
+		}
+
+		alias := models.IndexAlias{
+			Name:          aliasName,
+			IndexRouting:  index["index_routing"].(string),
+			SearchRouting: index["search_routing"].(string),
+			IsWriteIndex:  index["is_write_index"].(bool),
+			IsHidden:      isHidden,
+		}
+		if f := index["filter"].(string); f != "" {
+			filter := make(map[string]interface{})
+			if err := json.Unmarshal([]byte(f), &filter); err != nil {
+				return nil, diag.FromErr(err)
+			}
+			alias.Filter = filter
+		}
+		indices[indexName] = alias
+	}
+	return indices, diags
+}
+
+func resourceAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	aliasName := compId.ResourceId
+
+	indices, diags := elasticsearch.GetAlias(ctx, client, aliasName)
+	if indices == nil && diags == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`Alias "%s" not found, removing from state`, aliasName))
+		d.SetId("")
+		return diags
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("name", aliasName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	isHidden := false
+	aliasIndices := make([]interface{}, 0, len(indices))
+	for indexName, alias := range indices {
+		index := make(map[string]interface{})
+		index["name"] = indexName
+		index["filter"] = ""
+		if alias.Filter != nil {
+			f, err := json.Marshal(alias.Filter)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			index["filter"] = string(f)
+		}
+		index["index_routing"] = alias.IndexRouting
+		index["search_routing"] = alias.SearchRouting
+		index["is_write_index"] = alias.IsWriteIndex
+		isHidden = isHidden || alias.IsHidden
+		aliasIndices = append(aliasIndices, index)
+	}
+	if err := d.Set("index", aliasIndices); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("is_hidden", isHidden); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	aliasName := compId.ResourceId
+
+	actions := make([]models.IndexAliasAction, 0)
+	for _, i := range d.Get("index").(*schema.Set).List() {
+		actions = append(actions, models.IndexAliasAction{
+			Remove: &models.IndexAliasActionParams{Index: i.(map[string]interface{})["name"].(string), Alias: aliasName},
+		})
+	}
+	if diags := elasticsearch.UpdateAliases(ctx, client, actions); diags.HasError() {
+		return diags
+	}
+
+	return diags
+}
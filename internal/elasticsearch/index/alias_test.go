@@ -0,0 +1,159 @@
+package index_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceAlias(t *testing.T) {
+	// generate a random name
+	aliasName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceAliasDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAliasCreate(aliasName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_alias.test_alias", "name", aliasName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_alias.test_alias", "index.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("elasticstack_elasticsearch_alias.test_alias", "index.*", map[string]string{
+						"name":           aliasName + "-v1",
+						"is_write_index": "true",
+						"index_routing":  "shard-1",
+					}),
+				),
+			},
+			{
+				Config: testAccResourceAliasUpdate(aliasName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_alias.test_alias", "name", aliasName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_alias.test_alias", "index.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("elasticstack_elasticsearch_alias.test_alias", "index.*", map[string]string{
+						"name":           aliasName + "-v1",
+						"is_write_index": "false",
+						"filter":         `{"term":{"user.id":"developer"}}`,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("elasticstack_elasticsearch_alias.test_alias", "index.*", map[string]string{
+						"name":           aliasName + "-v2",
+						"is_write_index": "true",
+					}),
+				),
+			},
+			{
+				Config: testAccResourceAliasSwap(aliasName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_alias.test_alias", "index.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("elasticstack_elasticsearch_alias.test_alias", "index.*", map[string]string{
+						"name":           aliasName + "-v2",
+						"is_write_index": "true",
+					}),
+				),
+			},
+			{
+				ResourceName:      "elasticstack_elasticsearch_alias.test_alias",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"elasticsearch_connection",
+				},
+			},
+		},
+	})
+}
+
+const testAccResourceAliasIndices = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "v1" {
+  name = "%[1]s-v1"
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_index" "v2" {
+  name = "%[1]s-v2"
+  deletion_protection = false
+}
+`
+
+func testAccResourceAliasCreate(name string) string {
+	return fmt.Sprintf(testAccResourceAliasIndices+`
+resource "elasticstack_elasticsearch_alias" "test_alias" {
+  name = "%[1]s"
+
+  index {
+    name           = elasticstack_elasticsearch_index.v1.name
+    index_routing  = "shard-1"
+    is_write_index = true
+  }
+}
+	`, name)
+}
+
+func testAccResourceAliasUpdate(name string) string {
+	return fmt.Sprintf(testAccResourceAliasIndices+`
+resource "elasticstack_elasticsearch_alias" "test_alias" {
+  name = "%[1]s"
+
+  index {
+    name = elasticstack_elasticsearch_index.v1.name
+    filter = jsonencode({
+      term = { "user.id" = "developer" }
+    })
+  }
+
+  index {
+    name           = elasticstack_elasticsearch_index.v2.name
+    is_write_index = true
+  }
+}
+	`, name)
+}
+
+func testAccResourceAliasSwap(name string) string {
+	return fmt.Sprintf(testAccResourceAliasIndices+`
+resource "elasticstack_elasticsearch_alias" "test_alias" {
+  name = "%[1]s"
+
+  index {
+    name           = elasticstack_elasticsearch_index.v2.name
+    is_write_index = true
+  }
+}
+	`, name)
+}
+
+func checkResourceAliasDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_alias" {
+			continue
+		}
+		compId, _ := clients.CompositeIdFromStr(rs.Primary.ID)
+
+		req := client.GetESClient().Indices.GetAlias.WithName(compId.ResourceId)
+		res, err := client.GetESClient().Indices.GetAlias(req)
+		if err != nil {
+			return err
+		}
+
+		if res.StatusCode != 404 {
+			return fmt.Errorf("Alias (%s) still exists", compId.ResourceId)
+		}
+	}
+	return nil
+}
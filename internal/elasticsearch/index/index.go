@@ -6,16 +6,20 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -77,6 +81,29 @@ var (
 		"indexing.slowlog.source":                schema.TypeString,
 	}
 	allSettingsKeys = map[string]schema.ValueType{}
+	// Static settings, which can be updated on the closed index
+	closableSettingsKeys = []string{
+		"codec",
+		"load_fixed_bitset_filters_eagerly",
+		"shard.check_on_startup",
+	}
+	analysisFields = []string{
+		"analysis_analyzer",
+		"analysis_tokenizer",
+		"analysis_char_filter",
+		"analysis_filter",
+		"analysis_normalizer",
+	}
+	// Static settings which are not covered by the dedicated fields, the entries ending with "." are matched as prefixes
+	additionalStaticSettings = []string{
+		"index.analysis.",
+		"index.similarity.",
+		"index.soft_deletes.enabled",
+		"index.store.type",
+		"index.store.preload",
+		"index.mode",
+		"index.routing_path",
+	}
 )
 
 func init() {
@@ -122,8 +149,7 @@ func ResourceIndex() *schema.Resource {
 		},
 		"codec": {
 			Type:         schema.TypeString,
-			Description:  "The `default` value compresses stored data with LZ4 compression, but this can be set to `best_compression` which uses DEFLATE for a higher compression ratio. This can be set only on creation.",
-			ForceNew:     true,
+			Description:  "The `default` value compresses stored data with LZ4 compression, but this can be set to `best_compression` which uses DEFLATE for a higher compression ratio. Changing it re-creates the index, unless `allow_close_for_static_updates` is enabled.",
 			Optional:     true,
 			ValidateFunc: validation.StringInSlice([]string{"best_compression"}, false),
 		},
@@ -135,14 +161,12 @@ func ResourceIndex() *schema.Resource {
 		},
 		"load_fixed_bitset_filters_eagerly": {
 			Type:        schema.TypeBool,
-			Description: "Indicates whether cached filters are pre-loaded for nested queries. This can be set only on creation.",
-			ForceNew:    true,
+			Description: "Indicates whether cached filters are pre-loaded for nested queries. Changing it re-creates the index, unless `allow_close_for_static_updates` is enabled.",
 			Optional:    true,
 		},
 		"shard_check_on_startup": {
 			Type:         schema.TypeString,
-			Description:  "Whether or not shards should be checked for corruption before opening. When corruption is detected, it will prevent the shard from being opened. Accepts `false`, `true`, `checksum`.",
-			ForceNew:     true,
+			Description:  "Whether or not shards should be checked for corruption before opening. When corruption is detected, it will prevent the shard from being opened. Accepts `false`, `true`, `checksum`. Changing it re-creates the index, unless `allow_close_for_static_updates` is enabled.",
 			Optional:     true,
 			ValidateFunc: validation.StringInSlice([]string{"false", "true", "checksum"}, false),
 		},
@@ -384,35 +408,35 @@ func ResourceIndex() *schema.Resource {
 			Description: "Set the number of characters of the `_source` to include in the slowlog lines, `false` or `0` will skip logging the source entirely and setting it to `true` will log the entire source regardless of size. The original `_source` is reformatted by default to make sure that it fits on a single log line.",
 			Optional:    true,
 		},
-		// To change analyzer setting, the index must be closed, updated, and then reopened but it can't be handled in terraform.
-		// We raise error when they are tried to be updated instead of setting ForceNew not to have unexpected deletion.
+		// To change analyzer setting, the index must be closed, updated, and then reopened, which is done only if `allow_close_for_static_updates` is enabled.
+		// Otherwise we raise error when they are tried to be updated instead of setting ForceNew not to have unexpected deletion.
 		"analysis_analyzer": {
 			Type:         schema.TypeString,
-			Description:  "A JSON string describing the analyzers applied to the index.",
+			Description:  "A JSON string describing the analyzers applied to the index. It can be updated only when `allow_close_for_static_updates` is enabled.",
 			Optional:     true,
 			ValidateFunc: validation.StringIsJSON,
 		},
 		"analysis_tokenizer": {
 			Type:         schema.TypeString,
-			Description:  "A JSON string describing the tokenizers applied to the index.",
+			Description:  "A JSON string describing the tokenizers applied to the index. It can be updated only when `allow_close_for_static_updates` is enabled.",
 			Optional:     true,
 			ValidateFunc: validation.StringIsJSON,
 		},
 		"analysis_char_filter": {
 			Type:         schema.TypeString,
-			Description:  "A JSON string describing the char_filters applied to the index.",
+			Description:  "A JSON string describing the char_filters applied to the index. It can be updated only when `allow_close_for_static_updates` is enabled.",
 			Optional:     true,
 			ValidateFunc: validation.StringIsJSON,
 		},
 		"analysis_filter": {
 			Type:         schema.TypeString,
-			Description:  "A JSON string describing the filters applied to the index.",
+			Description:  "A JSON string describing the filters applied to the index. It can be updated only when `allow_close_for_static_updates` is enabled.",
 			Optional:     true,
 			ValidateFunc: validation.StringIsJSON,
 		},
 		"analysis_normalizer": {
 			Type:         schema.TypeString,
-			Description:  "A JSON string describing the normalizers applied to the index.",
+			Description:  "A JSON string describing the normalizers applied to the index. It can be updated only when `allow_close_for_static_updates` is enabled.",
 			Optional:     true,
 			ValidateFunc: validation.StringIsJSON,
 		},
@@ -471,13 +495,61 @@ func ResourceIndex() *schema.Resource {
 		"mappings": {
 			Description: `Mapping for fields in the index.
 If specified, this mapping can include: field names, [field data types](https://www.elastic.co/guide/en/elasticsearch/reference/current/mapping-types.html), [mapping parameters](https://www.elastic.co/guide/en/elasticsearch/reference/current/mapping-params.html).
-**NOTE:** changing datatypes in the existing _mappings_ will force index to be re-created.`,
+**NOTE:** changing datatypes in the existing _mappings_ or removing fields is handled according to ` + "`mapping_change_strategy`" + `.`,
 			Type:             schema.TypeString,
 			Optional:         true,
 			DiffSuppressFunc: utils.DiffJsonSuppress,
 			ValidateFunc:     validation.StringIsJSON,
 			Default:          "{}",
 		},
+		"mapping_change_strategy": {
+			Description:  "How to apply the mappings changes, which cannot be done in place (fields removed or field types changed): `recreate` re-creates the index and all the documents are lost, `fail` returns an error during the plan listing the incompatible fields, `reindex` copies the documents into a temporary index using `_reindex`, re-creates the index with the new mappings and copies the documents back. The writes to the index are blocked during the reindex, and the aliases point to the temporary index while the index is re-created. The original index is deleted only once its documents are copied, so the `reindex` strategy works with `deletion_protection` enabled. If the documents cannot be copied into the temporary index, e.g. because of the mapping conflicts, the temporary index is removed and the original index is kept.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "recreate",
+			ValidateFunc: validation.StringInSlice([]string{"recreate", "fail", "reindex"}, false),
+		},
+		"deletion_protection": {
+			Description: "Whether to allow Terraform to destroy the index. Unless this field is set to false in Terraform state, a `terraform destroy` or `terraform apply` command that deletes the index will fail. This includes the re-creation of the index, i.e. the incompatible mappings changes with `mapping_change_strategy = \"recreate\"`, and the changes of the static settings without `allow_close_for_static_updates`, but not the `reindex` strategy, which keeps the documents. When not configured, defaults to `true` for the newly created and imported indices, the indices created by the provider versions without this field stay unprotected.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"wait_for_active_shards": {
+			Description: "The number of shard copies that must be active before the index creation returns. Set to `all` or any positive integer up to the total number of shards in the index (`number_of_replicas+1`). Defaults to `1`, meaning only the primary shards.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"master_timeout": {
+			Description:  "Period to wait for a connection to the master node when creating or deleting the index, e.g. `30s`. If no response is received before the timeout expires, the request fails and returns an error.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: utils.ValidateDuration,
+		},
+		"timeout": {
+			Description:  "Period to wait for a response when creating or deleting the index, e.g. `30s`. If no response is received before the timeout expires, the request fails and returns an error.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: utils.ValidateDuration,
+		},
+		"allow_close_for_static_updates": {
+			Description: "If true, the static settings (`analysis_*`, `codec`, `load_fixed_bitset_filters_eagerly`, `shard_check_on_startup` and the static `custom_settings`) are updated in place: the index is closed, the settings are updated and the index is re-opened. The index is not available for reads and writes until it reaches the yellow health. The plan shows the settings updated this way in `static_update_notice`.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"static_update_notice": {
+			Description: "Set in the plan, when the index will be closed briefly and re-opened to update the static settings, lists the updated static settings. Keeps the value of the latest such update.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"custom_settings": {
+			Description:      "Map of index settings, which do not have a dedicated field, e.g. `mapping.total_fields.limit`. The keys can be provided with or without `index.` prefix, list values must be provided as comma separated string. Static settings can be set only on creation. The static settings are recognized during the plan by the list of the known static index settings (e.g. `index.analysis.*`, `index.similarity.*`, `index.store.type`, `index.mode`). The other static settings are detected only during the apply, when Elasticsearch rejects their update on the open index: with `allow_close_for_static_updates` they are updated on the closed index, which is not announced in the plan, otherwise the apply fails.",
+			Type:             schema.TypeMap,
+			Optional:         true,
+			Elem:             &schema.Schema{Type: schema.TypeString},
+			ValidateDiagFunc: validateCustomSettings,
+		},
 		// Deprecated: individual setting field should be used instead
 		"settings": {
 			Description: `DEPRECATED: Please use dedicated setting field. Configuration options for the index. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/index-modules.html#index-modules-settings.
@@ -535,6 +607,10 @@ If specified, this mapping can include: field names, [field data types](https://
 				if diags.HasError() {
 					return nil, fmt.Errorf("unable to import requested index")
 				}
+				// the imported index is protected the same way as the newly created one
+				if err := d.Set("deletion_protection", true); err != nil {
+					return nil, err
+				}
 
 				client, diags := clients.NewApiClient(d, m)
 				if diags.HasError() {
@@ -562,19 +638,9 @@ If specified, this mapping can include: field names, [field data types](https://
 							tflog.Warn(ctx, fmt.Sprintf("setting '%s' is not currently managed by terraform provider and has been ignored", key))
 							continue
 						}
-						switch typ {
-						case schema.TypeInt:
-							v, err := strconv.Atoi(value.(string))
-							if err != nil {
-								return nil, fmt.Errorf("failed to convert setting '%s' value %v to int: %w", key, value, err)
-							}
-							value = v
-						case schema.TypeBool:
-							v, err := strconv.ParseBool(value.(string))
-							if err != nil {
-								return nil, fmt.Errorf("failed to convert setting '%s' value %v to bool: %w", key, value, err)
-							}
-							value = v
+						value, err := convertSettingValue(key, typ, value)
+						if err != nil {
+							return nil, err
 						}
 						if err := d.Set(utils.ConvertSettingsKeyToTFFieldKey(key), value); err != nil {
 							return nil, err
@@ -585,35 +651,180 @@ If specified, this mapping can include: field names, [field data types](https://
 			},
 		},
 
-		CustomizeDiff: customdiff.ForceNewIfChange("mappings", func(ctx context.Context, old, new, meta interface{}) bool {
-			o := make(map[string]interface{})
-			if err := json.NewDecoder(strings.NewReader(old.(string))).Decode(&o); err != nil {
-				return true
-			}
-			n := make(map[string]interface{})
-			if err := json.NewDecoder(strings.NewReader(new.(string))).Decode(&n); err != nil {
-				return true
-			}
-			tflog.Trace(ctx, "mappings custom diff old = %+v new = %+v", o, n)
-
-			// if old defined we must check if the type of the existing fields were changed
-			if oldProps, ok := o["properties"]; ok {
-				newProps, ok := n["properties"]
-				// if the old has props but new one not, immediately force new resource
-				if !ok {
-					return true
-				}
-				return IsMappingForceNewRequired(oldProps.(map[string]interface{}), newProps.(map[string]interface{}))
-			}
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    (&schema.Resource{Schema: indexSchema}).CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeIndexStateV0,
+			},
+		},
 
-			// if all check passed, we can update the map
-			return false
-		}),
+		CustomizeDiff: customdiff.All(
+			customizeDeletionProtectionDiff,
+			customizeMappingsDiff,
+			customizeStaticSettingsDiff,
+		),
 
 		Schema: indexSchema,
 	}
 }
 
+// The indices created before `deletion_protection` was introduced stay unprotected, unless it is configured
+func upgradeIndexStateV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+	if v, ok := rawState["deletion_protection"]; !ok || v == nil {
+		rawState["deletion_protection"] = false
+	}
+	return rawState, nil
+}
+
+// Protects the newly created indices, when `deletion_protection` is not configured
+func customizeDeletionProtectionDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() && !rawConfig.GetAttr("deletion_protection").IsNull() {
+		return nil
+	}
+	return d.SetNew("deletion_protection", true)
+}
+
+func customizeMappingsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("mappings") {
+		return nil
+	}
+	o, n := d.GetChange("mappings")
+	fields := incompatibleMappingsChanges(ctx, o.(string), n.(string))
+	if len(fields) == 0 {
+		return nil
+	}
+
+	switch d.Get("mapping_change_strategy").(string) {
+	case "fail":
+		return fmt.Errorf("mappings changes of the fields %v cannot be applied in place, update the mappings or use other `mapping_change_strategy`", fields)
+	case "reindex":
+		// the index is re-created during the update
+		return nil
+	default:
+		return d.ForceNew("mappings")
+	}
+}
+
+// Returns the fields, which prevent the mappings to be updated in place
+func incompatibleMappingsChanges(ctx context.Context, old, new string) []string {
+	o := make(map[string]interface{})
+	if err := json.NewDecoder(strings.NewReader(old)).Decode(&o); err != nil {
+		return []string{"mappings"}
+	}
+	n := make(map[string]interface{})
+	if err := json.NewDecoder(strings.NewReader(new)).Decode(&n); err != nil {
+		return []string{"mappings"}
+	}
+	tflog.Trace(ctx, fmt.Sprintf("mappings custom diff old = %+v new = %+v", o, n))
+
+	// if old defined we must check if the type of the existing fields were changed
+	if oldProps, ok := o["properties"]; ok {
+		newProps, ok := n["properties"]
+		// if the old has props but new one not, all the fields are removed
+		if !ok {
+			newProps = map[string]interface{}{}
+		}
+		return IncompatibleMappingFields(oldProps.(map[string]interface{}), newProps.(map[string]interface{}))
+	}
+
+	// if all check passed, we can update the map
+	return nil
+}
+
+// Static settings can be changed only on the closed index, which is done only if explicitly allowed.
+// Otherwise the index is re-created or the error is raised not to have unexpected deletion.
+func customizeStaticSettingsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	allowClose := d.Get("allow_close_for_static_updates").(bool)
+
+	for _, key := range closableSettingsKeys {
+		fieldKey := utils.ConvertSettingsKeyToTFFieldKey(key)
+		if !d.HasChange(fieldKey) {
+			continue
+		}
+		if !allowClose {
+			if err := d.ForceNew(fieldKey); err != nil {
+				return err
+			}
+		}
+	}
+	for _, key := range analysisFields {
+		if d.HasChange(key) && !allowClose {
+			return fmt.Errorf("`%s` can be updated only when `allow_close_for_static_updates` is enabled", key)
+		}
+	}
+	if d.HasChange("custom_settings") {
+		o, n := d.GetChange("custom_settings")
+		if keys := ChangedStaticSettings(o.(map[string]interface{}), n.(map[string]interface{})); len(keys) > 0 && !allowClose {
+			return fmt.Errorf("static index settings %v can be set only on the index creation, unless `allow_close_for_static_updates` is enabled", keys)
+		}
+	}
+
+	if updates := closedIndexUpdates(d); allowClose && len(updates) > 0 {
+		return d.SetNew("static_update_notice", staticUpdateNotice(updates))
+	}
+	return nil
+}
+
+// Returns the fields and custom settings, which are updated by closing and re-opening the index
+func closedIndexUpdates(d interface {
+	HasChange(string) bool
+	GetChange(string) (interface{}, interface{})
+}) []string {
+	updates := make([]string, 0)
+	for _, key := range closableSettingsKeys {
+		if fieldKey := utils.ConvertSettingsKeyToTFFieldKey(key); d.HasChange(fieldKey) {
+			updates = append(updates, fieldKey)
+		}
+	}
+	for _, key := range analysisFields {
+		if d.HasChange(key) {
+			updates = append(updates, key)
+		}
+	}
+	if d.HasChange("custom_settings") {
+		o, n := d.GetChange("custom_settings")
+		for _, k := range ChangedStaticSettings(o.(map[string]interface{}), n.(map[string]interface{})) {
+			updates = append(updates, "custom_settings."+k)
+		}
+	}
+	return updates
+}
+
+func staticUpdateNotice(updates []string) string {
+	return fmt.Sprintf("the index will be closed briefly and re-opened to update: %s", strings.Join(updates, ", "))
+}
+
+func validateCustomSettings(value interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for k := range value.(map[string]interface{}) {
+		key := strings.TrimPrefix(k, "index.")
+		if _, ok := allSettingsKeys[key]; ok {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Setting has a dedicated field.",
+				Detail:        fmt.Sprintf("setting '%s' must be configured with the `%s` field instead.", k, utils.ConvertSettingsKeyToTFFieldKey(key)),
+				AttributePath: path,
+			})
+		}
+	}
+	return diags
+}
+
 func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClient(d, meta)
 	if diags.HasError() {
@@ -624,14 +835,30 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	if diags.HasError() {
 		return diags
 	}
+	index, diags := expandIndex(ctx, d)
+	if diags.HasError() {
+		return diags
+	}
+	index.WaitForActiveShards = d.Get("wait_for_active_shards").(string)
+	index.MasterTimeout, index.Timeout = expandRequestTimeouts(d)
+
+	if diags := elasticsearch.PutIndex(ctx, client, index); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.String())
+	return resourceIndexRead(ctx, d, meta)
+}
+
+func expandIndex(ctx context.Context, d *schema.ResourceData) (*models.Index, diag.Diagnostics) {
 	var index models.Index
-	index.Name = indexName
+	index.Name = d.Get("name").(string)
 
 	if v, ok := d.GetOk("alias"); ok {
 		aliases := v.(*schema.Set)
 		als, diags := ExpandIndexAliases(aliases)
 		if diags.HasError() {
-			return diags
+			return nil, diags
 		}
 		index.Aliases = als
 	}
@@ -640,7 +867,7 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		maps := make(map[string]interface{})
 		if v.(string) != "" {
 			if err := json.Unmarshal([]byte(v.(string)), &maps); err != nil {
-				return diag.FromErr(err)
+				return nil, diag.FromErr(err)
 			}
 		}
 		index.Mappings = maps
@@ -657,7 +884,7 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		bytes := []byte(analyzerJSON.(string))
 		err := json.Unmarshal(bytes, &analyzer)
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, diag.FromErr(err)
 		}
 		analysis["analyzer"] = analyzer
 	}
@@ -666,7 +893,7 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		bytes := []byte(tokenizerJSON.(string))
 		err := json.Unmarshal(bytes, &tokenizer)
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, diag.FromErr(err)
 		}
 		analysis["tokenizer"] = tokenizer
 	}
@@ -674,7 +901,7 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		var filter map[string]interface{}
 		bytes := []byte(charFilterJSON.(string))
 		if err := json.Unmarshal(bytes, &filter); err != nil {
-			return diag.FromErr(err)
+			return nil, diag.FromErr(err)
 		}
 		analysis["char_filter"] = filter
 	}
@@ -683,7 +910,7 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		bytes := []byte(filterJSON.(string))
 		err := json.Unmarshal(bytes, &filter)
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, diag.FromErr(err)
 		}
 		analysis["filter"] = filter
 	}
@@ -692,7 +919,7 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		bytes := []byte(normalizerJSON.(string))
 		err := json.Unmarshal(bytes, &normalizer)
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, diag.FromErr(err)
 		}
 		analysis["normalizer"] = normalizer
 	}
@@ -700,6 +927,12 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		index.Settings["analysis"] = analysis
 	}
 
+	if v, ok := d.GetOk("custom_settings"); ok {
+		for k, value := range v.(map[string]interface{}) {
+			index.Settings[strings.TrimPrefix(k, "index.")] = value
+		}
+	}
+
 	if v, ok := d.GetOk("settings"); ok {
 		// we know at this point we have 1 and only 1 `settings` block defined
 		managedSettings := v.([]interface{})[0].(map[string]interface{})["setting"].(*schema.Set)
@@ -707,18 +940,13 @@ func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			setting := s.(map[string]interface{})
 			name := setting["name"].(string)
 			if _, ok := index.Settings[name]; ok {
-				return diag.FromErr(fmt.Errorf("setting '%s' is already defined by the other field, please remove it from `settings` to avoid unexpected settings", name))
+				return nil, diag.FromErr(fmt.Errorf("setting '%s' is already defined by the other field, please remove it from `settings` to avoid unexpected settings", name))
 			}
 			index.Settings[name] = setting["value"]
 		}
 	}
 
-	if diags := elasticsearch.PutIndex(ctx, client, &index); diags.HasError() {
-		return diags
-	}
-
-	d.SetId(id.String())
-	return resourceIndexRead(ctx, d, meta)
+	return &index, nil
 }
 
 // Because of limitation of ES API we must handle changes to aliases, mappings and settings separately
@@ -729,6 +957,17 @@ func resourceIndexUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	indexName := d.Get("name").(string)
 
+	if d.HasChange("mappings") && d.Get("mapping_change_strategy").(string) == "reindex" {
+		o, n := d.GetChange("mappings")
+		if fields := incompatibleMappingsChanges(ctx, o.(string), n.(string)); len(fields) > 0 {
+			tflog.Info(ctx, fmt.Sprintf("mappings changes of the fields %v cannot be applied in place, reindexing the index %s", fields, indexName))
+			if diags := reindexIndex(ctx, client, d); diags.HasError() {
+				return diags
+			}
+			return resourceIndexRead(ctx, d, meta)
+		}
+	}
+
 	// aliases
 	if d.HasChange("alias") {
 		oldAliases, newAliases := d.GetChange("alias")
@@ -771,6 +1010,43 @@ func resourceIndexUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			updatedSettings[key] = d.Get(fieldKey)
 		}
 	}
+	// static settings, which can be updated only on the closed index
+	staticSettings := make(map[string]interface{})
+	for _, key := range closableSettingsKeys {
+		fieldKey := utils.ConvertSettingsKeyToTFFieldKey(key)
+		if d.HasChange(fieldKey) {
+			v := d.Get(fieldKey)
+			if v == "" {
+				v = nil
+			}
+			staticSettings[key] = v
+		}
+	}
+	analysis, diags := expandAnalysisChanges(d)
+	if diags.HasError() {
+		return diags
+	}
+	if len(analysis) > 0 {
+		staticSettings["analysis"] = analysis
+	}
+	if d.HasChange("custom_settings") {
+		oldSettings, newSettings := d.GetChange("custom_settings")
+		ns := newSettings.(map[string]interface{})
+		for k, ov := range oldSettings.(map[string]interface{}) {
+			if nv, ok := ns[k]; !ok {
+				ns[k] = nil
+			} else if nv == ov {
+				delete(ns, k)
+			}
+		}
+		for k, v := range ns {
+			if IsStaticIndexSetting(k) {
+				staticSettings[strings.TrimPrefix(k, "index.")] = v
+			} else {
+				updatedSettings[strings.TrimPrefix(k, "index.")] = v
+			}
+		}
+	}
 	if d.HasChange("settings") {
 		oldSettings, newSettings := d.GetChange("settings")
 		os := flattenIndexSettings(oldSettings.([]interface{}))
@@ -795,12 +1071,60 @@ func resourceIndexUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			}
 		}
 	}
+	var warnings diag.Diagnostics
 	if len(updatedSettings) > 0 {
 		tflog.Trace(ctx, fmt.Sprintf("settings to update: %+v", updatedSettings))
-		if diags := elasticsearch.UpdateIndexSettings(ctx, client, indexName, updatedSettings); diags.HasError() {
+		diags := elasticsearch.UpdateIndexSettings(ctx, client, indexName, updatedSettings)
+		// the static settings missing from the list of the known ones are rejected by Elasticsearch,
+		// they are moved to the update of the closed index, when it is allowed
+		rejected := nonDynamicSettings(diags)
+		if len(rejected) > 0 && !d.Get("allow_close_for_static_updates").(bool) {
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Static index settings cannot be updated",
+				Detail:   fmt.Sprintf("The static index settings %v can be set only on the index creation, unless `allow_close_for_static_updates` is enabled.", rejected),
+			})
+		}
+		if len(rejected) > 0 {
+			for _, key := range rejected {
+				for _, k := range []string{key, strings.TrimPrefix(key, "index.")} {
+					if v, ok := updatedSettings[k]; ok {
+						staticSettings[k] = v
+						delete(updatedSettings, k)
+					}
+				}
+			}
+			warnings = append(warnings, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Unknown static index settings",
+				Detail:   fmt.Sprintf("The index settings %v are static, but not known as static by the provider, so the plan did not announce that the index is closed to update them.", rejected),
+			})
+			diags = nil
+			if len(updatedSettings) > 0 {
+				diags = elasticsearch.UpdateIndexSettings(ctx, client, indexName, updatedSettings)
+			}
+		}
+		if diags.HasError() {
 			return diags
 		}
 	}
+	if len(staticSettings) > 0 {
+		tflog.Trace(ctx, fmt.Sprintf("static settings to update: %+v", staticSettings))
+		if diags := updateClosedIndexSettings(ctx, client, indexName, staticSettings, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+			return diags
+		}
+		// the same value as in the plan, which announced the update
+		if updates := closedIndexUpdates(d); len(updates) > 0 {
+			if err := d.Set("static_update_notice", staticUpdateNotice(updates)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		warnings = append(warnings, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Index was closed to update the static settings",
+			Detail:   fmt.Sprintf("The index %s was closed to update the static settings %s and re-opened, it was not available for reads and writes in the meantime.", indexName, strings.Join(utils.SortedKeys(staticSettings), ", ")),
+		})
+	}
 
 	// mappings
 	if d.HasChange("mappings") {
@@ -811,7 +1135,181 @@ func resourceIndexUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
-	return resourceIndexRead(ctx, d, meta)
+	return append(warnings, resourceIndexRead(ctx, d, meta)...)
+}
+
+var nonDynamicSettingsRegexp = regexp.MustCompile(`non dynamic settings \[\[([^\]]*)\]\]`)
+
+// Returns the settings, which were rejected by Elasticsearch as static on the open index
+func nonDynamicSettings(diags diag.Diagnostics) []string {
+	for _, d := range diags {
+		if m := nonDynamicSettingsRegexp.FindStringSubmatch(d.Detail); m != nil {
+			return strings.Split(m[1], ", ")
+		}
+	}
+	return nil
+}
+
+// Returns the changed analysis components, the removed ones are set to nil
+func expandAnalysisChanges(d *schema.ResourceData) (map[string]interface{}, diag.Diagnostics) {
+	analysis := make(map[string]interface{})
+	for _, key := range analysisFields {
+		if !d.HasChange(key) {
+			continue
+		}
+		o, n := d.GetChange(key)
+		oldComponents := make(map[string]interface{})
+		if o.(string) != "" {
+			if err := json.Unmarshal([]byte(o.(string)), &oldComponents); err != nil {
+				return nil, diag.FromErr(err)
+			}
+		}
+		components := make(map[string]interface{})
+		if n.(string) != "" {
+			if err := json.Unmarshal([]byte(n.(string)), &components); err != nil {
+				return nil, diag.FromErr(err)
+			}
+		}
+		for k := range oldComponents {
+			if _, ok := components[k]; !ok {
+				components[k] = nil
+			}
+		}
+		analysis[strings.TrimPrefix(key, "analysis_")] = components
+	}
+	return analysis, nil
+}
+
+// Closes the index, updates the settings and re-opens the index waiting for at least yellow health
+func updateClosedIndexSettings(ctx context.Context, client *clients.ApiClient, indexName string, settings map[string]interface{}, timeout time.Duration) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("closing the index %s to update the static settings", indexName))
+	if diags := elasticsearch.CloseIndex(ctx, client, indexName); diags.HasError() {
+		return diags
+	}
+	diags := elasticsearch.UpdateIndexSettings(ctx, client, indexName, settings)
+	// the index must be re-opened even if the settings update failed
+	if openDiags := elasticsearch.OpenIndex(ctx, client, indexName); openDiags.HasError() {
+		return append(diags, openDiags...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+	return elasticsearch.WaitForIndexHealth(ctx, client, indexName, "yellow", timeout)
+}
+
+// Re-creates the index with the new configuration keeping all the documents:
+// the documents are copied into a temporary index, which holds the aliases while the index is re-created,
+// then the documents are copied back and the aliases are swapped again.
+func reindexIndex(ctx context.Context, client *clients.ApiClient, d *schema.ResourceData) diag.Diagnostics {
+	index, diags := expandIndex(ctx, d)
+	if diags.HasError() {
+		return diags
+	}
+	// `deletion_protection` is not checked: the original index is deleted only once its documents are copied and kept until then
+	oldAliases, _ := d.GetChange("alias")
+	tmpAliases, diags := ExpandIndexAliases(oldAliases.(*schema.Set))
+	if diags.HasError() {
+		return diags
+	}
+	newAliases := index.Aliases
+	index.Aliases = nil
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	masterTimeout, requestTimeout := expandRequestTimeouts(d)
+	oldBlocksWrite, _ := d.GetChange("blocks_write")
+
+	tmpIndex := *index
+	tmpIndex.Name = fmt.Sprintf("%s-reindex-%d", index.Name, time.Now().Unix())
+
+	// the writes are blocked, so no documents are lost while they are copied
+	if diags := elasticsearch.UpdateIndexSettings(ctx, client, index.Name, map[string]interface{}{"index.blocks.write": true}); diags.HasError() {
+		return diags
+	}
+	// until the aliases are moved, the original index keeps all the documents, and the temporary index is removed on failure
+	rollback := func(diags diag.Diagnostics, createdTmpIndex bool) diag.Diagnostics {
+		if createdTmpIndex {
+			diags = append(diags, elasticsearch.DeleteIndex(ctx, client, tmpIndex.Name, masterTimeout, requestTimeout)...)
+		}
+		var blocksWrite interface{}
+		if oldBlocksWrite.(bool) {
+			blocksWrite = true
+		}
+		diags = append(diags, elasticsearch.UpdateIndexSettings(ctx, client, index.Name, map[string]interface{}{"index.blocks.write": blocksWrite})...)
+		return append(diags, reindexFailure(index.Name, fmt.Sprintf("The documents remain in the original index %s, the changes of the mappings were not applied.", index.Name)))
+	}
+	if diags := elasticsearch.PutIndex(ctx, client, &tmpIndex); diags.HasError() {
+		return rollback(diags, false)
+	}
+	if diags := copyIndexDocuments(ctx, client, index.Name, tmpIndex.Name, timeout); diags.HasError() {
+		return rollback(diags, true)
+	}
+	if diags := elasticsearch.UpdateIndexSettings(ctx, client, tmpIndex.Name, map[string]interface{}{"index.blocks.write": true}); diags.HasError() {
+		return rollback(diags, true)
+	}
+	if diags := elasticsearch.UpdateAliases(ctx, client, swapIndexActions(index.Name, tmpIndex.Name, tmpAliases)); diags.HasError() {
+		return rollback(diags, true)
+	}
+
+	// from now on the documents are only in the temporary index, which is kept on failure
+	if diags := elasticsearch.PutIndex(ctx, client, index); diags.HasError() {
+		return append(diags, reindexFailure(index.Name, fmt.Sprintf("The original index %s was deleted, the documents are in the temporary index %s, which holds the aliases of the index.", index.Name, tmpIndex.Name)))
+	}
+	if diags := copyIndexDocuments(ctx, client, tmpIndex.Name, index.Name, timeout); diags.HasError() {
+		diags = append(diags, elasticsearch.DeleteIndex(ctx, client, index.Name, masterTimeout, requestTimeout)...)
+		return append(diags, reindexFailure(index.Name, fmt.Sprintf("The original index %s was deleted, the documents are in the temporary index %s, which holds the aliases of the index.", index.Name, tmpIndex.Name)))
+	}
+	if diags := elasticsearch.UpdateAliases(ctx, client, swapIndexActions(tmpIndex.Name, index.Name, newAliases)); diags.HasError() {
+		return append(diags, reindexFailure(index.Name, fmt.Sprintf("The documents are copied into the re-created index %s, but the temporary index %s still holds the aliases and must be removed manually.", index.Name, tmpIndex.Name)))
+	}
+	return nil
+}
+
+func reindexFailure(index, detail string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("Unable to reindex the index %s", index),
+		Detail:   detail,
+	}
+}
+
+// Moves the aliases to the new index and deletes the old one in the single atomic request
+func swapIndexActions(oldIndex, newIndex string, aliases map[string]models.IndexAlias) []models.IndexAliasAction {
+	actions := make([]models.IndexAliasAction, 0, len(aliases)+1)
+	for name, alias := range aliases {
+		actions = append(actions, models.IndexAliasAction{
+			Add: &models.IndexAliasActionParams{IndexAlias: alias, Index: newIndex, Alias: name},
+		})
+	}
+	return append(actions, models.IndexAliasAction{
+		RemoveIndex: &models.IndexAliasActionParams{Index: oldIndex},
+	})
+}
+
+func copyIndexDocuments(ctx context.Context, client *clients.ApiClient, source, dest string, timeout time.Duration) diag.Diagnostics {
+	taskId, diags := elasticsearch.Reindex(ctx, client, source, dest)
+	if diags.HasError() {
+		return diags
+	}
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		task, diags := elasticsearch.GetTask(ctx, client, taskId)
+		if diags.HasError() {
+			return resource.NonRetryableError(fmt.Errorf("unable to get the reindex task %s: %v", taskId, diags))
+		}
+		if !task.Completed {
+			return resource.RetryableError(fmt.Errorf("reindex task %s is still running", taskId))
+		}
+		if task.Error != nil {
+			return resource.NonRetryableError(fmt.Errorf("reindex of %s into %s failed: %v", source, dest, task.Error))
+		}
+		if task.Response != nil && len(task.Response.Failures) > 0 {
+			return resource.NonRetryableError(fmt.Errorf("reindex of %s into %s failed: %v", source, dest, task.Response.Failures))
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
 func flattenIndexSettings(settings []interface{}) map[string]interface{} {
@@ -826,6 +1324,142 @@ func flattenIndexSettings(settings []interface{}) map[string]interface{} {
 	return ns
 }
 
+// Updates the dedicated settings fields and `custom_settings` which are defined in the state with the values read from the index
+func setIndexSettingsFields(d *schema.ResourceData, indexSettings *models.IndexSettings) diag.Diagnostics {
+	lookup := func(key string) (interface{}, bool) {
+		key = normalizeIndexSettingKey(key)
+		if v, ok := indexSettings.Settings[key]; ok {
+			return v, true
+		}
+		v, ok := indexSettings.Defaults[key]
+		return v, ok
+	}
+
+	for key, typ := range allSettingsKeys {
+		fieldKey := utils.ConvertSettingsKeyToTFFieldKey(key)
+		if !isAttributeSet(d, fieldKey) {
+			continue
+		}
+		value, ok := lookup(key)
+		if !ok {
+			continue
+		}
+		value, err := convertSettingValue(key, typ, value)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set(fieldKey, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if v, ok := d.GetOk("custom_settings"); ok {
+		customSettings := make(map[string]interface{})
+		for key := range v.(map[string]interface{}) {
+			// the settings which are not set on the index anymore are dropped, so the diff is shown
+			if value, ok := indexSettings.Settings[normalizeIndexSettingKey(key)]; ok {
+				customSettings[key] = flattenSettingValue(value)
+			}
+		}
+		if err := d.Set("custom_settings", customSettings); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// Reports whether the attribute is set in the configuration or in the state, including its zero value, e.g. `number_of_replicas = 0`
+func isAttributeSet(d *schema.ResourceData, key string) bool {
+	if _, ok := d.GetOk(key); ok {
+		return true
+	}
+	for _, raw := range []cty.Value{d.GetRawConfig(), d.GetRawState()} {
+		if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute(key) {
+			continue
+		}
+		if !raw.GetAttr(key).IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeIndexSettingKey(key string) string {
+	if strings.HasPrefix(key, "index.") {
+		return key
+	}
+	return "index." + key
+}
+
+// The flat settings are returned as strings, except for the list settings
+func flattenSettingValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []interface{}:
+		values := make([]string, len(v))
+		for i, e := range v {
+			values[i] = fmt.Sprint(e)
+		}
+		return strings.Join(values, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func convertSettingValue(key string, typ schema.ValueType, value interface{}) (interface{}, error) {
+	switch typ {
+	case schema.TypeInt:
+		v, err := strconv.Atoi(fmt.Sprint(value))
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert setting '%s' value %v to int: %w", key, value, err)
+		}
+		return v, nil
+	case schema.TypeBool:
+		v, err := strconv.ParseBool(fmt.Sprint(value))
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert setting '%s' value %v to bool: %w", key, value, err)
+		}
+		return v, nil
+	case schema.TypeSet:
+		if s, ok := value.(string); ok {
+			return strings.Split(s, ","), nil
+		}
+	}
+	return value, nil
+}
+
+// IsStaticIndexSetting reports whether the setting can be set only on the index creation (or on the closed index)
+func IsStaticIndexSetting(key string) bool {
+	if _, ok := staticSettingsKeys[strings.TrimPrefix(key, "index.")]; ok {
+		return true
+	}
+	key = normalizeIndexSettingKey(key)
+	for _, s := range additionalStaticSettings {
+		if key == s || (strings.HasSuffix(s, ".") && strings.HasPrefix(key, s)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ChangedStaticSettings returns the sorted list of static settings, which were added, removed or changed
+func ChangedStaticSettings(old, new map[string]interface{}) []string {
+	changed := make([]string, 0)
+	for k, ov := range old {
+		if nv, ok := new[k]; (!ok || nv != ov) && IsStaticIndexSetting(k) {
+			changed = append(changed, k)
+		}
+	}
+	for k := range new {
+		if _, ok := old[k]; !ok && IsStaticIndexSetting(k) {
+			changed = append(changed, k)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
 func resourceIndexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClient(d, meta)
 	if diags.HasError() {
@@ -870,8 +1504,17 @@ func resourceIndexRead(ctx context.Context, d *schema.ResourceData, meta interfa
 			return diag.FromErr(err)
 		}
 	}
-	// TODO: We ideally should set read settings to each field to detect changes
-	// But for now, setting it will cause unexpected diff for the existing clients which use `settings`
+	// `settings_raw` holds the settings explicitly set on the index, while the managed fields
+	// are refreshed from the flat settings, falling back to the defaults, to detect the drift
+	indexSettings, diags := elasticsearch.GetIndexSettings(ctx, client, indexName)
+	if diags.HasError() {
+		return diags
+	}
+	if indexSettings != nil {
+		if diags := setIndexSettingsFields(d, indexSettings); diags.HasError() {
+			return diags
+		}
+	}
 	if index.Settings != nil {
 		s, err := json.Marshal(index.Settings)
 		if err != nil {
@@ -894,44 +1537,72 @@ func resourceIndexDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	if diags.HasError() {
 		return diags
 	}
-	if diags := elasticsearch.DeleteIndex(ctx, client, compId.ResourceId); diags.HasError() {
+	if d.Get("deletion_protection").(bool) {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Index is protected from deletion",
+				Detail:   fmt.Sprintf("Cannot delete the index %s, because `deletion_protection` is enabled. Set it to false and apply the change before deleting the index.", compId.ResourceId),
+			},
+		}
+	}
+	masterTimeout, timeout := expandRequestTimeouts(d)
+	if diags := elasticsearch.DeleteIndex(ctx, client, compId.ResourceId, masterTimeout, timeout); diags.HasError() {
 		return diags
 	}
 	return diags
 }
 
+// The values are already validated, so the parsing errors are ignored
+func expandRequestTimeouts(d *schema.ResourceData) (masterTimeout, timeout time.Duration) {
+	if v, ok := d.GetOk("master_timeout"); ok {
+		masterTimeout, _ = time.ParseDuration(v.(string))
+	}
+	if v, ok := d.GetOk("timeout"); ok {
+		timeout, _ = time.ParseDuration(v.(string))
+	}
+	return
+}
+
 func IsMappingForceNewRequired(old map[string]interface{}, new map[string]interface{}) bool {
+	return len(IncompatibleMappingFields(old, new)) > 0
+}
+
+// IncompatibleMappingFields returns the sorted paths of the fields, which were removed or had their type changed
+func IncompatibleMappingFields(old map[string]interface{}, new map[string]interface{}) []string {
+	fields := incompatibleMappingFields("", old, new)
+	sort.Strings(fields)
+	return fields
+}
+
+func incompatibleMappingFields(prefix string, old map[string]interface{}, new map[string]interface{}) []string {
+	fields := make([]string, 0)
 	for k, v := range old {
+		path := prefix + k
 		oldFieldSettings := v.(map[string]interface{})
-		if newFieldSettings, ok := new[k]; ok {
-			newSettings := newFieldSettings.(map[string]interface{})
-			// check if the "type" field exists and match with new one
-			if s, ok := oldFieldSettings["type"]; ok {
-				if ns, ok := newSettings["type"]; ok {
-					if !reflect.DeepEqual(s, ns) {
-						return true
-					}
-					continue
-				} else {
-					return true
-				}
+		newFieldSettings, ok := new[k]
+		// if the key not found in the new props, the field was removed
+		if !ok {
+			fields = append(fields, path)
+			continue
+		}
+		newSettings := newFieldSettings.(map[string]interface{})
+		// check if the "type" field exists and match with new one
+		if s, ok := oldFieldSettings["type"]; ok {
+			if ns, ok := newSettings["type"]; !ok || !reflect.DeepEqual(s, ns) {
+				fields = append(fields, path)
 			}
+			continue
+		}
 
-			// if we have "mapping" field, let's call ourself to check again
-			if s, ok := oldFieldSettings["properties"]; ok {
-				if ns, ok := newSettings["properties"]; ok {
-					if IsMappingForceNewRequired(s.(map[string]interface{}), ns.(map[string]interface{})) {
-						return true
-					}
-					continue
-				} else {
-					return true
-				}
+		// if we have "mapping" field, let's call ourself to check again
+		if s, ok := oldFieldSettings["properties"]; ok {
+			if ns, ok := newSettings["properties"]; ok {
+				fields = append(fields, incompatibleMappingFields(path+".", s.(map[string]interface{}), ns.(map[string]interface{}))...)
+			} else {
+				fields = append(fields, path)
 			}
-		} else {
-			// if the key not found in the new props, force new resource
-			return true
 		}
 	}
-	return false
+	return fields
 }
@@ -2,6 +2,7 @@ package index_test
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"testing"
 
@@ -95,6 +96,193 @@ func TestAccResourceIndexSettings(t *testing.T) {
 	})
 }
 
+func TestAccResourceIndexCustomSettings(t *testing.T) {
+	indexName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceIndexDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIndexCustomSettingsCreate(indexName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_custom_settings", "custom_settings.%", "2"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_custom_settings", "custom_settings.mapping.total_fields.limit", "2000"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_custom_settings", "custom_settings.index.store.type", "fs"),
+				),
+			},
+			{
+				Config: testAccResourceIndexCustomSettingsUpdate(indexName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_custom_settings", "custom_settings.%", "2"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_custom_settings", "custom_settings.mapping.total_fields.limit", "3000"),
+				),
+			},
+			{
+				Config:      testAccResourceIndexCustomSettingsStaticUpdate(indexName),
+				ExpectError: regexp.MustCompile(`static index settings \[index.store.type\] can be set only on the index creation`),
+			},
+			{
+				Config:      testAccResourceIndexCustomSettingsDedicatedField(indexName),
+				ExpectError: regexp.MustCompile("must be configured with the `number_of_replicas` field instead"),
+			},
+		},
+	})
+}
+
+func TestAccResourceIndexMappingChangeStrategy(t *testing.T) {
+	indexName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceIndexDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIndexMappingChangeStrategy(indexName, "reindex", `field1 = { type = "text" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_strategy", "mapping_change_strategy", "reindex"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_strategy", "mappings", `{"properties":{"field1":{"type":"text"}}}`),
+				),
+			},
+			{
+				Config: testAccResourceIndexMappingChangeStrategy(indexName, "reindex", `field1 = { type = "keyword" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_strategy", "mappings", `{"properties":{"field1":{"type":"keyword"}}}`),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_strategy", "alias.0.name", "test_alias"),
+				),
+			},
+			{
+				Config:      testAccResourceIndexMappingChangeStrategy(indexName, "fail", `field2 = { type = "keyword" }`),
+				ExpectError: regexp.MustCompile(`mappings changes of the fields \[field1\] cannot be applied in place`),
+			},
+		},
+	})
+}
+
+func TestAccResourceIndexUnknownStaticCustomSettings(t *testing.T) {
+	indexName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceIndexDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIndexQueriesCache(indexName, false, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_queries_cache", "custom_settings.index.queries.cache.enabled", "false"),
+				),
+			},
+			{
+				// `index.queries.cache.enabled` is static, but it is not in the list of the known static settings
+				Config:      testAccResourceIndexQueriesCache(indexName, false, "true"),
+				ExpectError: regexp.MustCompile(`can be set only on the index creation, unless ` + "`allow_close_for_static_updates`" + ` is enabled`),
+			},
+			{
+				Config: testAccResourceIndexQueriesCache(indexName, true, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_queries_cache", "custom_settings.index.queries.cache.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceIndexReindexWithDeletionProtection(t *testing.T) {
+	indexName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceIndexDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIndexReindexProtected(indexName, "", "text"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_reindex", "deletion_protection", "true"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_reindex", "mappings", `{"properties":{"field1":{"type":"text"}}}`),
+				),
+			},
+			{
+				Config: testAccResourceIndexReindexProtected(indexName, "", "keyword"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_reindex", "deletion_protection", "true"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_reindex", "mappings", `{"properties":{"field1":{"type":"keyword"}}}`),
+				),
+			},
+			{
+				// unprotect the index to destroy it
+				Config: testAccResourceIndexReindexProtected(indexName, "deletion_protection = false", "keyword"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_reindex", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceIndexStaticSettingsUpdate(t *testing.T) {
+	indexName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceIndexDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIndexStaticSettings(indexName, false, "standard"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_static", "analysis_analyzer", `{"text_en":{"tokenizer":"standard","type":"custom"}}`),
+				),
+			},
+			{
+				Config:      testAccResourceIndexStaticSettings(indexName, false, "whitespace"),
+				ExpectError: regexp.MustCompile("`analysis_analyzer` can be updated only when `allow_close_for_static_updates` is enabled"),
+			},
+			{
+				Config: testAccResourceIndexStaticSettings(indexName, true, "whitespace"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_static", "analysis_analyzer", `{"text_en":{"tokenizer":"whitespace","type":"custom"}}`),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_static", "codec", "best_compression"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_static", "static_update_notice", "the index will be closed briefly and re-opened to update: codec, analysis_analyzer"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceIndexDeletionProtection(t *testing.T) {
+	indexName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceIndexDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIndexDeletionProtection(indexName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_protection", "deletion_protection", "true"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_protection", "wait_for_active_shards", "all"),
+				),
+			},
+			{
+				Config:      testAccResourceIndexDeletionProtection(indexName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Index is protected from deletion"),
+			},
+			{
+				Config: testAccResourceIndexDeletionProtection(indexName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test_protection", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceIndexSettingsMigration(t *testing.T) {
 	indexName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
 
@@ -148,6 +336,7 @@ provider "elasticstack" {
 
 resource "elasticstack_elasticsearch_index" "test" {
   name = "%s"
+  deletion_protection = false
 
   alias {
     name = "test_alias_1"
@@ -191,6 +380,7 @@ provider "elasticstack" {
 
 resource "elasticstack_elasticsearch_index" "test" {
   name = "%s"
+  deletion_protection = false
 
   alias {
     name = "test_alias_1"
@@ -213,6 +403,7 @@ provider "elasticstack" {
 
 resource "elasticstack_elasticsearch_index" "test_settings" {
   name = "%s"
+  deletion_protection = false
 
   mappings = jsonencode({
     properties = {
@@ -288,6 +479,7 @@ provider "elasticstack" {
 
 resource "elasticstack_elasticsearch_index" "test_settings_migration" {
   name = "%s"
+  deletion_protection = false
 
   settings {
     setting {
@@ -307,6 +499,7 @@ provider "elasticstack" {
 
 resource "elasticstack_elasticsearch_index" "test_settings_migration" {
   name = "%s"
+  deletion_protection = false
 
   number_of_replicas = 1
 }
@@ -321,6 +514,7 @@ provider "elasticstack" {
 
 resource "elasticstack_elasticsearch_index" "test_settings_conflict" {
   name = "%s"
+  deletion_protection = false
 
   mappings = jsonencode({
     properties = {
@@ -340,6 +534,187 @@ resource "elasticstack_elasticsearch_index" "test_settings_conflict" {
 	`, name)
 }
 
+func testAccResourceIndexCustomSettingsCreate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test_custom_settings" {
+  name = "%s"
+  deletion_protection = false
+
+  custom_settings = {
+    "mapping.total_fields.limit" = "2000"
+    "index.store.type"           = "fs"
+  }
+}
+	`, name)
+}
+
+func testAccResourceIndexCustomSettingsUpdate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test_custom_settings" {
+  name = "%s"
+  deletion_protection = false
+
+  custom_settings = {
+    "mapping.total_fields.limit" = "3000"
+    "index.store.type"           = "fs"
+  }
+}
+	`, name)
+}
+
+func testAccResourceIndexCustomSettingsStaticUpdate(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test_custom_settings" {
+  name = "%s"
+  deletion_protection = false
+
+  custom_settings = {
+    "mapping.total_fields.limit" = "3000"
+    "index.store.type"           = "niofs"
+  }
+}
+	`, name)
+}
+
+func testAccResourceIndexCustomSettingsDedicatedField(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test_custom_settings" {
+  name = "%s"
+  deletion_protection = false
+
+  custom_settings = {
+    "index.number_of_replicas" = "2"
+  }
+}
+	`, name)
+}
+
+func testAccResourceIndexMappingChangeStrategy(name, strategy, properties string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test_strategy" {
+  name = "%s"
+  deletion_protection = false
+
+  alias {
+    name = "test_alias"
+  }
+
+  mappings = jsonencode({
+    properties = {
+      %s
+    }
+  })
+
+  mapping_change_strategy = "%s"
+}
+	`, name, properties, strategy)
+}
+
+func testAccResourceIndexQueriesCache(name string, allowClose bool, enabled string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test_queries_cache" {
+  name                = "%s"
+  deletion_protection = false
+
+  allow_close_for_static_updates = %t
+
+  custom_settings = {
+    "index.queries.cache.enabled" = "%s"
+  }
+}
+	`, name, allowClose, enabled)
+}
+
+func testAccResourceIndexReindexProtected(name, protection, fieldType string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test_reindex" {
+  name = "%s"
+  %s
+
+  mappings = jsonencode({
+    properties = {
+      field1 = { type = "%s" }
+    }
+  })
+
+  mapping_change_strategy = "reindex"
+}
+	`, name, protection, fieldType)
+}
+
+func testAccResourceIndexStaticSettings(name string, allowClose bool, tokenizer string) string {
+	codec := ""
+	if allowClose {
+		codec = `codec = "best_compression"`
+	}
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test_static" {
+  name = "%s"
+  deletion_protection = false
+
+  allow_close_for_static_updates = %t
+  %s
+
+  analysis_analyzer = jsonencode({
+    text_en = {
+      type      = "custom"
+      tokenizer = "%s"
+    }
+  })
+}
+	`, name, allowClose, codec, tokenizer)
+}
+
+func testAccResourceIndexDeletionProtection(name string, protected bool) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test_protection" {
+  name                = "%s"
+  deletion_protection = %t
+
+  number_of_replicas     = 0
+  wait_for_active_shards = "all"
+  master_timeout         = "1m"
+  timeout                = "1m"
+}
+	`, name, protected)
+}
+
 func checkResourceIndexDestroy(s *terraform.State) error {
 	client, err := clients.NewAcceptanceTestingClient()
 	if err != nil {
@@ -445,3 +820,99 @@ func Test_IsMappingForceNewRequired(t *testing.T) {
 		})
 	}
 }
+
+func Test_IsStaticIndexSetting(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{key: "number_of_shards", want: true},
+		{key: "index.number_of_shards", want: true},
+		{key: "index.codec", want: true},
+		{key: "index.analysis.analyzer.my_analyzer.type", want: true},
+		{key: "similarity.my_similarity.type", want: true},
+		{key: "index.store.type", want: true},
+		{key: "index.soft_deletes.enabled", want: true},
+		{key: "index.number_of_replicas", want: false},
+		{key: "mapping.total_fields.limit", want: false},
+		{key: "index.store.stats_refresh_interval", want: false},
+		{key: "index.analysis", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := index.IsStaticIndexSetting(tt.key); got != tt.want {
+				t.Errorf("IsStaticIndexSetting() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ChangedStaticSettings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		old  map[string]interface{}
+		new  map[string]interface{}
+		want []string
+	}{
+		{
+			name: "dynamic settings changes are ignored",
+			old:  map[string]interface{}{"mapping.total_fields.limit": "1000"},
+			new:  map[string]interface{}{"mapping.total_fields.limit": "2000"},
+			want: []string{},
+		},
+		{
+			name: "changed, added and removed static settings are returned",
+			old: map[string]interface{}{
+				"index.store.type":     "fs",
+				"index.mode":           "standard",
+				"index.store.preload":  "nvd",
+				"index.max_ngram_diff": "2",
+			},
+			new: map[string]interface{}{
+				"index.store.type":           "niofs",
+				"index.store.preload":        "nvd",
+				"index.soft_deletes.enabled": "true",
+			},
+			want: []string{"index.mode", "index.soft_deletes.enabled", "index.store.type"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := index.ChangedStaticSettings(tt.old, tt.new); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChangedStaticSettings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_IncompatibleMappingFields(t *testing.T) {
+	t.Parallel()
+
+	old := map[string]interface{}{
+		"field1": map[string]interface{}{"type": "text"},
+		"field2": map[string]interface{}{"type": "keyword"},
+		"parent": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"child1": map[string]interface{}{"type": "keyword"},
+				"child2": map[string]interface{}{"type": "keyword"},
+			},
+		},
+	}
+	new := map[string]interface{}{
+		"field1": map[string]interface{}{"type": "integer"},
+		"field3": map[string]interface{}{"type": "keyword"},
+		"parent": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"child1": map[string]interface{}{"type": "keyword"},
+			},
+		},
+	}
+	want := []string{"field1", "field2", "parent.child2"}
+	if got := index.IncompatibleMappingFields(old, new); !reflect.DeepEqual(got, want) {
+		t.Errorf("IncompatibleMappingFields() = %v, want %v", got, want)
+	}
+}
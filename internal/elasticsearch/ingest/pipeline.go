@@ -4,18 +4,45 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var PipelineDeprecatedMinVersion = version.Must(version.NewVersion("8.12.0")) // Pipelines can be deprecated since 8.12
+
+var (
+	ProcessorGeoGridMinVersion    = version.Must(version.NewVersion("8.7.0"))
+	ProcessorIpLocationMinVersion = version.Must(version.NewVersion("8.16.0"))
+	ProcessorRedactMinVersion     = version.Must(version.NewVersion("8.11.0"))
+	ProcessorRerouteMinVersion    = version.Must(version.NewVersion("8.8.0"))
+	ProcessorTerminateMinVersion  = version.Must(version.NewVersion("8.16.0"))
+)
+
+// The minimal versions of Elasticsearch supporting the processors, or the processor options given as `<processor>.<option>`
+var processorMinVersions = map[string]*version.Version{
+	"geo_grid":                       ProcessorGeoGridMinVersion,
+	"inference.ignore_missing":       version.Must(version.NewVersion("8.11.0")),
+	"inference.input_output":         version.Must(version.NewVersion("8.11.0")),
+	"ip_location":                    ProcessorIpLocationMinVersion,
+	"redact":                         ProcessorRedactMinVersion,
+	"redact.trace_redact":            version.Must(version.NewVersion("8.16.0")),
+	"remove.keep":                    version.Must(version.NewVersion("8.4.0")),
+	"reroute":                        ProcessorRerouteMinVersion,
+	"terminate":                      ProcessorTerminateMinVersion,
+	"user_agent.extract_device_type": version.Must(version.NewVersion("8.0.0")),
+}
+
 func ResourceIngestPipeline() *schema.Resource {
 	pipelineSchema := map[string]*schema.Schema{
 		"id": {
@@ -42,7 +69,7 @@ func ResourceIngestPipeline() *schema.Resource {
 			Elem: &schema.Schema{
 				Type:             schema.TypeString,
 				ValidateFunc:     validation.StringIsJSON,
-				DiffSuppressFunc: utils.DiffJsonSuppress,
+				DiffSuppressFunc: diffProcessorSuppress,
 			},
 		},
 		"processors": {
@@ -53,7 +80,7 @@ func ResourceIngestPipeline() *schema.Resource {
 			Elem: &schema.Schema{
 				Type:             schema.TypeString,
 				ValidateFunc:     validation.StringIsJSON,
-				DiffSuppressFunc: utils.DiffJsonSuppress,
+				DiffSuppressFunc: diffProcessorSuppress,
 			},
 		},
 		"metadata": {
@@ -63,6 +90,18 @@ func ResourceIngestPipeline() *schema.Resource {
 			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: utils.DiffJsonSuppress,
 		},
+		"version": {
+			Description:  "Version number used by external systems to track ingest pipelines.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"deprecated": {
+			Description: "Marks the ingest pipeline as deprecated. The deprecation warning is emitted, when the deprecated pipeline is used. Supported from Elasticsearch version **8.12**",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
 	}
 
 	utils.AddConnectionSchema(pipelineSchema)
@@ -79,6 +118,11 @@ func ResourceIngestPipeline() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customdiff.All(
+			customizeProcessorsDiff,
+			customizePipelineReferencesDiff,
+		),
+
 		Schema: pipelineSchema,
 	}
 }
@@ -128,6 +172,21 @@ func resourceIngestPipelineTemplatePut(ctx context.Context, d *schema.ResourceDa
 		}
 		pipeline.Metadata = metadata
 	}
+	if v, ok := d.GetOk("version"); ok {
+		pipelineVersion := v.(int)
+		pipeline.Version = &pipelineVersion
+	}
+	if d.Get("deprecated").(bool) {
+		serverVersion, diags := client.ServerVersion(ctx)
+		if diags.HasError() {
+			return diags
+		}
+		if serverVersion.LessThan(PipelineDeprecatedMinVersion) {
+			return diag.Errorf("'deprecated' is supported only for Elasticsearch v%s and above", PipelineDeprecatedMinVersion.String())
+		}
+		deprecated := true
+		pipeline.Deprecated = &deprecated
+	}
 
 	if diags := elasticsearch.PutIngestPipeline(ctx, client, &pipeline); diags.HasError() {
 		return diags
@@ -168,7 +227,7 @@ func resourceIngestPipelineTemplateRead(ctx context.Context, d *schema.ResourceD
 	if onFailure := pipeline.OnFailure; onFailure != nil {
 		fProcs := make([]string, len(onFailure))
 		for i, v := range onFailure {
-			res, err := json.Marshal(v)
+			res, err := json.Marshal(stripProcessorDefaults(v))
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -181,7 +240,7 @@ func resourceIngestPipelineTemplateRead(ctx context.Context, d *schema.ResourceD
 	}
 	procs := make([]string, len(pipeline.Processors))
 	for i, v := range pipeline.Processors {
-		res, err := json.Marshal(v)
+		res, err := json.Marshal(stripProcessorDefaults(v))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -201,6 +260,20 @@ func resourceIngestPipelineTemplateRead(ctx context.Context, d *schema.ResourceD
 			return diag.FromErr(err)
 		}
 	}
+	pipelineVersion := 0
+	if pipeline.Version != nil {
+		pipelineVersion = *pipeline.Version
+	}
+	if err := d.Set("version", pipelineVersion); err != nil {
+		return diag.FromErr(err)
+	}
+	deprecated := false
+	if pipeline.Deprecated != nil {
+		deprecated = *pipeline.Deprecated
+	}
+	if err := d.Set("deprecated", deprecated); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return diags
 }
@@ -222,3 +295,235 @@ func resourceIngestPipelineTemplateDelete(ctx context.Context, d *schema.Resourc
 
 	return diags
 }
+
+// The default values of the processor options, which are added by the processor data sources
+var (
+	commonProcessorDefaults = map[string]interface{}{
+		"ignore_failure": false,
+		"ignore_missing": false,
+	}
+	processorDefaults = map[string]map[string]interface{}{
+		"append":            {"allow_duplicates": true},
+		"community_id":      {"ignore_missing": true},
+		"csv":               {"trim": false},
+		"dot_expander":      {"override": false},
+		"enrich":            {"override": true},
+		"geoip":             {"first_only": true},
+		"grok":              {"trace_match": false},
+		"ip_location":       {"first_only": true},
+		"json":              {"allow_duplicate_keys": false},
+		"kv":                {"strip_brackets": false},
+		"network_direction": {"ignore_missing": true},
+		"set":               {"override": true, "ignore_empty_value": false, "media_type": "application/json"},
+		"split":             {"preserve_trailing": false},
+		"uri_parts":         {"keep_original": true, "remove_if_successful": false},
+		"user_agent":        {"extract_device_type": false},
+	}
+)
+
+// Returns the copy of the processor without the options set to their default values, including the nested processors
+func stripProcessorDefaults(processor map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(processor))
+	for processorType, v := range processor {
+		options, ok := v.(map[string]interface{})
+		if !ok {
+			result[processorType] = v
+			continue
+		}
+		stripped := make(map[string]interface{}, len(options))
+		for option, value := range options {
+			defaultValue, ok := processorDefaults[processorType][option]
+			if !ok {
+				defaultValue, ok = commonProcessorDefaults[option]
+			}
+			if ok && reflect.DeepEqual(value, defaultValue) {
+				continue
+			}
+			switch option {
+			case "on_failure":
+				if list, ok := value.([]interface{}); ok {
+					processors := make([]interface{}, len(list))
+					for i, p := range list {
+						if p, ok := p.(map[string]interface{}); ok {
+							processors[i] = stripProcessorDefaults(p)
+						} else {
+							processors[i] = p
+						}
+					}
+					value = processors
+				}
+			case "processor":
+				if p, ok := value.(map[string]interface{}); ok && processorType == "foreach" {
+					value = stripProcessorDefaults(p)
+				}
+			}
+			stripped[option] = value
+		}
+		result[processorType] = stripped
+	}
+	return result
+}
+
+// Compares the processors ignoring the options set to their default values
+func diffProcessorSuppress(k, old, new string, d *schema.ResourceData) bool {
+	var o, n map[string]interface{}
+	if err := json.Unmarshal([]byte(old), &o); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &n); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(stripProcessorDefaults(o), stripProcessorDefaults(n))
+}
+
+// Fails on plan, if the pipeline processors reference the ingest pipelines, which do not exist.
+// The names unknown during the plan reference the other resources, and the templated names are resolved only when the documents are ingested, so they are not checked.
+func customizePipelineReferencesDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("processors", "on_failure") {
+		return nil
+	}
+	processors, err := diffProcessors(d)
+	if err != nil {
+		return err
+	}
+	references := make([]string, 0)
+	for _, processor := range processors {
+		for _, name := range pipelineReferences(processor) {
+			if name != "" && !strings.Contains(name, "{{") {
+				references = append(references, name)
+			}
+		}
+	}
+	if len(references) == 0 {
+		return nil
+	}
+
+	client, diags := clients.NewApiClientFromDiff(d, meta)
+	if diags.HasError() {
+		return fmt.Errorf("unable to create the Elasticsearch client: %v", diags)
+	}
+	for _, name := range references {
+		pipeline, diags := elasticsearch.GetIngestPipeline(ctx, client, &name)
+		if diags.HasError() {
+			tflog.Warn(ctx, fmt.Sprintf(`Unable to get the ingest pipeline "%s", skipping the validation of the reference: %v`, name, diags))
+			continue
+		}
+		if pipeline == nil {
+			return fmt.Errorf(`the pipeline processor references the ingest pipeline "%s", which does not exist. If the pipeline is managed by the elasticstack_elasticsearch_ingest_pipeline resource, reference its name attribute, so it is created first`, name)
+		}
+	}
+	return nil
+}
+
+// Returns the known processors and on_failure processors of the diff, the processors unknown during the plan are read as empty strings and skipped
+func diffProcessors(d *schema.ResourceDiff) ([]map[string]interface{}, error) {
+	processors := make([]map[string]interface{}, 0)
+	for _, key := range []string{"processors", "on_failure"} {
+		for _, v := range d.Get(key).([]interface{}) {
+			if v.(string) == "" {
+				continue
+			}
+			processor := make(map[string]interface{})
+			if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&processor); err != nil {
+				return nil, err
+			}
+			processors = append(processors, processor)
+		}
+	}
+	return processors, nil
+}
+
+// Fails on plan, if any of the processors, or their options, is not supported by the Elasticsearch version of the cluster
+func customizeProcessorsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("processors", "on_failure") {
+		return nil
+	}
+	// the processors are checked only when all of them are known
+	if !d.NewValueKnown("processors") || !d.NewValueKnown("on_failure") {
+		return nil
+	}
+	processors, err := diffProcessors(d)
+	if err != nil {
+		return err
+	}
+	features := make(map[string]bool)
+	for _, processor := range processors {
+		versionedProcessorFeatures(processor, features)
+	}
+	if len(features) == 0 {
+		return nil
+	}
+
+	client, diags := clients.NewApiClientFromDiff(d, meta)
+	if diags.HasError() {
+		return fmt.Errorf("unable to create the Elasticsearch client: %v", diags)
+	}
+	serverVersion, diags := client.ServerVersion(ctx)
+	if diags.HasError() {
+		return fmt.Errorf("unable to get the Elasticsearch version: %v", diags)
+	}
+	for _, feature := range utils.SortedKeys(features) {
+		minVersion := processorMinVersions[feature]
+		if !serverVersion.LessThan(minVersion) {
+			continue
+		}
+		if processorType, option, ok := strings.Cut(feature, "."); ok {
+			return fmt.Errorf("'%s' of the '%s' processor is supported only for Elasticsearch v%s and above", option, processorType, minVersion.String())
+		}
+		return fmt.Errorf("'%s' processor is supported only for Elasticsearch v%s and above", feature, minVersion.String())
+	}
+	return nil
+}
+
+// Collects the processors and options of the processor having the minimal Elasticsearch version, including the nested processors
+func versionedProcessorFeatures(processor map[string]interface{}, features map[string]bool) {
+	for processorType, v := range processor {
+		if _, ok := processorMinVersions[processorType]; ok {
+			features[processorType] = true
+		}
+		options, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for option := range options {
+			if _, ok := processorMinVersions[processorType+"."+option]; ok {
+				features[processorType+"."+option] = true
+			}
+		}
+		if p, ok := options["processor"].(map[string]interface{}); ok && processorType == "foreach" {
+			versionedProcessorFeatures(p, features)
+		}
+		if list, ok := options["on_failure"].([]interface{}); ok {
+			for _, p := range list {
+				if p, ok := p.(map[string]interface{}); ok {
+					versionedProcessorFeatures(p, features)
+				}
+			}
+		}
+	}
+}
+
+// Returns the names of the pipelines referenced by the pipeline processors, including the nested processors
+func pipelineReferences(processor map[string]interface{}) []string {
+	result := make([]string, 0)
+	for processorType, v := range processor {
+		options, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, ok := options["name"].(string); ok && processorType == "pipeline" {
+			result = append(result, name)
+		}
+		if p, ok := options["processor"].(map[string]interface{}); ok && processorType == "foreach" {
+			result = append(result, pipelineReferences(p)...)
+		}
+		if list, ok := options["on_failure"].([]interface{}); ok {
+			for _, p := range list {
+				if p, ok := p.(map[string]interface{}); ok {
+					result = append(result, pipelineReferences(p)...)
+				}
+			}
+		}
+	}
+	return result
+}
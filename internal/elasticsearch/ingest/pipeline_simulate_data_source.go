@@ -0,0 +1,273 @@
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIngestPipelineSimulate() *schema.Resource {
+	simulatedDocSchema := map[string]*schema.Schema{
+		"index": {
+			Description: "The name of the index of the document.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"id": {
+			Description: "The ID of the document.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"source": {
+			Description: "The `_source` of the document as JSON. Empty when the document is dropped by the pipeline.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"error": {
+			Description: "The error as JSON, if the pipeline failed for the document.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	processorResultSchema := map[string]*schema.Schema{
+		"processor_type": {
+			Description: "The type of the processor, e.g. `grok`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"tag": {
+			Description: "The tag of the processor.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"status": {
+			Description: "The status of the processor run: `success`, `error`, `error_ignored`, `skipped` or `dropped`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"source": {
+			Description: "The `_source` of the document as JSON after the processor run.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"error": {
+			Description: "The error of the processor as JSON.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	simulateSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"pipeline_id": {
+			Description:  "The name of the existing ingest pipeline to simulate.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"pipeline_id", "processors"},
+		},
+		"processors": {
+			Description:  "Processors of the inline pipeline to simulate, in the same form as the `processors` of the `elasticstack_elasticsearch_ingest_pipeline` resource. Each record must be a valid JSON document.",
+			Type:         schema.TypeList,
+			Optional:     true,
+			MinItems:     1,
+			ExactlyOneOf: []string{"pipeline_id", "processors"},
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsJSON,
+			},
+		},
+		"on_failure": {
+			Description:   "Processors of the inline pipeline to run immediately after a processor failure. Each record must be a valid JSON document.",
+			Type:          schema.TypeList,
+			Optional:      true,
+			MinItems:      1,
+			ConflictsWith: []string{"pipeline_id"},
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsJSON,
+			},
+		},
+		"docs": {
+			Description: "Sample documents to run through the pipeline. Each record must be a valid JSON document with the `_source`, and optionally `_index` and `_id` of the document.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsJSON,
+			},
+		},
+		"verbose": {
+			Description: "If `true`, the results of the individual processors are returned in `processor_results`.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"documents": {
+			Description: "The documents resulting from the pipeline, in the order of `docs`.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: utils.MergeSchemaMaps(simulatedDocSchema, map[string]*schema.Schema{
+					"processor_results": {
+						Description: "The results of the individual processors, when `verbose` is enabled.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: processorResultSchema,
+						},
+					},
+				}),
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(simulateSchema)
+
+	return &schema.Resource{
+		Description: "Runs an ingest pipeline against a set of sample documents, e.g. to test the pipelines composed of the processor data sources. See: https://www.elastic.co/guide/en/elasticsearch/reference/current/simulate-pipeline-api.html",
+
+		ReadContext: dataSourceIngestPipelineSimulateRead,
+
+		Schema: simulateSchema,
+	}
+}
+
+func dataSourceIngestPipelineSimulateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	simulation := models.IngestPipelineSimulation{
+		PipelineID: d.Get("pipeline_id").(string),
+		Verbose:    d.Get("verbose").(bool),
+	}
+	docs, err := expandJsonObjects(d.Get("docs").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	simulation.Docs = docs
+	if v, ok := d.GetOk("processors"); ok {
+		var pipeline models.IngestPipeline
+		if pipeline.Processors, err = expandJsonObjects(v.([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+		if v, ok := d.GetOk("on_failure"); ok {
+			if pipeline.OnFailure, err = expandJsonObjects(v.([]interface{})); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		simulation.Pipeline = &pipeline
+	}
+
+	results, diags := elasticsearch.SimulateIngestPipeline(ctx, client, &simulation)
+	if diags.HasError() {
+		return diags
+	}
+
+	documents := make([]interface{}, len(results))
+	for i, result := range results {
+		document, err := flattenSimulatedDoc(result.Doc, result.Error)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		processorResults := make([]interface{}, len(result.ProcessorResults))
+		for j, processorResult := range result.ProcessorResults {
+			r, err := flattenSimulatedDoc(processorResult.Doc, processorResult.Error)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			processorResults[j] = map[string]interface{}{
+				"processor_type": processorResult.ProcessorType,
+				"tag":            processorResult.Tag,
+				"status":         processorResult.Status,
+				"source":         r["source"],
+				"error":          r["error"],
+			}
+			// the document resulting from the whole pipeline is the one from the last processor
+			if processorResult.Doc != nil {
+				document["index"] = r["index"]
+				document["id"] = r["id"]
+				document["source"] = r["source"]
+			}
+			if processorResult.Error != nil && processorResult.Status == "error" {
+				document["error"] = r["error"]
+			}
+		}
+		// the dropped document is not returned by the pipeline, as in the non-verbose mode
+		if n := len(result.ProcessorResults); n > 0 && result.ProcessorResults[n-1].Status == "dropped" {
+			document["index"] = ""
+			document["id"] = ""
+			document["source"] = ""
+		}
+		document["processor_results"] = processorResults
+		documents[i] = document
+	}
+	if err := d.Set("documents", documents); err != nil {
+		return diag.FromErr(err)
+	}
+
+	simulationJson, err := json.Marshal(map[string]interface{}{"pipeline_id": simulation.PipelineID, "verbose": simulation.Verbose, "simulation": simulation})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	hash, err := utils.StringToHash(string(simulationJson))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(*hash)
+
+	return diags
+}
+
+func expandJsonObjects(list []interface{}) ([]map[string]interface{}, error) {
+	result := make([]map[string]interface{}, len(list))
+	for i, v := range list {
+		item := make(map[string]interface{})
+		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&item); err != nil {
+			return nil, err
+		}
+		result[i] = item
+	}
+	return result, nil
+}
+
+func flattenSimulatedDoc(doc *models.IngestDocument, docError map[string]interface{}) (map[string]interface{}, error) {
+	result := map[string]interface{}{
+		"index":  "",
+		"id":     "",
+		"source": "",
+		"error":  "",
+	}
+	if doc != nil {
+		source, err := json.Marshal(doc.Source)
+		if err != nil {
+			return nil, err
+		}
+		result["index"] = doc.Index
+		result["id"] = doc.Id
+		result["source"] = string(source)
+	}
+	if docError != nil {
+		errorJson, err := json.Marshal(docError)
+		if err != nil {
+			return nil, err
+		}
+		result["error"] = string(errorJson)
+	}
+	return result, nil
+}
@@ -0,0 +1,114 @@
+package ingest_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIngestPipelineSimulate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIngestPipelineSimulate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_pipeline_simulate.test", "documents.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_pipeline_simulate.test", "documents.0.id", "1"),
+					CheckResourceJson("data.elasticstack_elasticsearch_ingest_pipeline_simulate.test", "documents.0.source", `{"message":"55.3.244.1 GET /index.html","client":"55.3.244.1","method":"GET","request":"/index.html"}`),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_pipeline_simulate.test", "documents.0.error", ""),
+					resource.TestMatchResourceAttr("data.elasticstack_elasticsearch_ingest_pipeline_simulate.test", "documents.1.error", regexp.MustCompile("Provided Grok expressions do not match field value")),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_pipeline_simulate.verbose", "documents.0.processor_results.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_pipeline_simulate.verbose", "documents.0.processor_results.0.processor_type", "grok"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_pipeline_simulate.verbose", "documents.0.processor_results.0.status", "success"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_pipeline_simulate.verbose", "documents.0.processor_results.1.tag", "uppercase-method"),
+					CheckResourceJson("data.elasticstack_elasticsearch_ingest_pipeline_simulate.verbose", "documents.0.source", `{"message":"55.3.244.1 get /index.html","client":"55.3.244.1","method":"GET","request":"/index.html"}`),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_pipeline_simulate.dropped", "documents.#", "2"),
+					CheckResourceJson("data.elasticstack_elasticsearch_ingest_pipeline_simulate.dropped", "documents.0.source", `{"message":"kept","client":"55.3.244.1"}`),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_pipeline_simulate.dropped", "documents.1.processor_results.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_pipeline_simulate.dropped", "documents.1.processor_results.1.status", "dropped"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_pipeline_simulate.dropped", "documents.1.source", ""),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_pipeline_simulate.dropped", "documents.1.id", ""),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceIngestPipelineSimulate = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_grok" "test" {
+  field    = "message"
+  patterns = ["%%{IP:client} %%{WORD:method} %%{URIPATHPARAM:request}"]
+}
+
+data "elasticstack_elasticsearch_ingest_pipeline_simulate" "test" {
+  processors = [
+    data.elasticstack_elasticsearch_ingest_processor_grok.test.json
+  ]
+
+  docs = [
+    jsonencode({
+      _id     = "1"
+      _source = { message = "55.3.244.1 GET /index.html" }
+    }),
+    jsonencode({
+      _id     = "2"
+      _source = { message = "not matching" }
+    })
+  ]
+}
+
+data "elasticstack_elasticsearch_ingest_pipeline_simulate" "verbose" {
+  processors = [
+    data.elasticstack_elasticsearch_ingest_processor_grok.test.json,
+    jsonencode({
+      uppercase = {
+        tag   = "uppercase-method"
+        field = "method"
+      }
+    })
+  ]
+  verbose = true
+
+  docs = [
+    jsonencode({
+      _source = { message = "55.3.244.1 get /index.html" }
+    })
+  ]
+}
+
+data "elasticstack_elasticsearch_ingest_pipeline_simulate" "dropped" {
+  processors = [
+    jsonencode({
+      set = {
+        field = "client"
+        value = "55.3.244.1"
+      }
+    }),
+    jsonencode({
+      drop = {
+        if = "ctx.message == 'dropped'"
+      }
+    })
+  ]
+  verbose = true
+
+  docs = [
+    jsonencode({
+      _id     = "1"
+      _source = { message = "kept" }
+    }),
+    jsonencode({
+      _id     = "2"
+      _source = { message = "dropped" }
+    })
+  ]
+}
+`
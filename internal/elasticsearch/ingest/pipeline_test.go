@@ -2,10 +2,13 @@ package ingest_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/ingest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -39,6 +42,96 @@ func TestAccResourceIngestPipeline(t *testing.T) {
 	})
 }
 
+func TestAccResourceIngestPipelineFromDataSources(t *testing.T) {
+	pipelineName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceIngestPipelineDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIngestPipelineFromDataSources(pipelineName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ingest_pipeline.test_pipeline", "name", pipelineName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ingest_pipeline.test_pipeline", "version", "2"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ingest_pipeline.test_pipeline", "deprecated", "false"),
+					CheckResourceJson("elasticstack_elasticsearch_ingest_pipeline.test_pipeline", "processors.0", `{"grok":{"field":"message","patterns":["%{WORD:method}"]}}`),
+					CheckResourceJson("elasticstack_elasticsearch_ingest_pipeline.test_pipeline", "processors.1", `{"set":{"field":"processed","value":"true"}}`),
+				),
+			},
+			{
+				ResourceName:            "elasticstack_elasticsearch_ingest_pipeline.test_pipeline",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"elasticsearch_connection"},
+			},
+		},
+	})
+}
+
+func TestAccResourceIngestPipelineDeprecated(t *testing.T) {
+	pipelineName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceIngestPipelineDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(ingest.PipelineDeprecatedMinVersion),
+				Config:   testAccResourceIngestPipelineDeprecated(pipelineName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ingest_pipeline.test_pipeline", "deprecated", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceIngestPipelineVersionedProcessors(t *testing.T) {
+	pipelineName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceIngestPipelineDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(ingest.ProcessorRerouteMinVersion),
+				Config:   testAccResourceIngestPipelineVersionedProcessors(pipelineName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ingest_pipeline.test_pipeline", "name", pipelineName),
+					CheckResourceJson("elasticstack_elasticsearch_ingest_pipeline.test_pipeline", "processors.0", `{"reroute":{"dataset":["{{service.name}}","generic"],"namespace":["default"]}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceIngestPipelineReferences(t *testing.T) {
+	pipelineName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceIngestPipelineDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceIngestPipelineMissingReference(pipelineName),
+				ExpectError: regexp.MustCompile(`references the ingest pipeline "` + pipelineName + `-missing", which does not exist`),
+			},
+			{
+				Config: testAccResourceIngestPipelineReferences(pipelineName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_ingest_pipeline.main", "name", pipelineName),
+					CheckResourceJson("elasticstack_elasticsearch_ingest_pipeline.main", "processors.0", fmt.Sprintf(`{"pipeline":{"name":"%s-child"}}`, pipelineName)),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourceIngestPipelineCreate(name string) string {
 	return fmt.Sprintf(`
 provider "elasticstack" {
@@ -92,6 +185,130 @@ resource "elasticstack_elasticsearch_ingest_pipeline" "test_pipeline" {
 	`, name)
 }
 
+func testAccResourceIngestPipelineFromDataSources(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_grok" "grok" {
+  field    = "message"
+  patterns = ["%%%%{WORD:method}"]
+}
+
+data "elasticstack_elasticsearch_ingest_processor_set" "set" {
+  field = "processed"
+  value = "true"
+}
+
+resource "elasticstack_elasticsearch_ingest_pipeline" "test_pipeline" {
+  name    = "%s"
+  version = 2
+
+  processors = [
+    data.elasticstack_elasticsearch_ingest_processor_grok.grok.json,
+    data.elasticstack_elasticsearch_ingest_processor_set.set.json
+  ]
+}
+	`, name)
+}
+
+func testAccResourceIngestPipelineVersionedProcessors(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_reroute" "reroute" {
+  dataset   = ["{{service.name}}", "generic"]
+  namespace = ["default"]
+}
+
+resource "elasticstack_elasticsearch_ingest_pipeline" "test_pipeline" {
+  name = "%s"
+
+  processors = [
+    data.elasticstack_elasticsearch_ingest_processor_reroute.reroute.json
+  ]
+}
+	`, name)
+}
+
+func testAccResourceIngestPipelineDeprecated(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_ingest_pipeline" "test_pipeline" {
+  name       = "%s"
+  deprecated = true
+
+  processors = [
+    jsonencode({
+      set = {
+        field = "processed"
+        value = "true"
+      }
+    })
+  ]
+}
+	`, name)
+}
+
+func testAccResourceIngestPipelineMissingReference(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_ingest_pipeline" "main" {
+  name = "%s"
+
+  processors = [
+    jsonencode({
+      pipeline = {
+        name = "%s-missing"
+      }
+    })
+  ]
+}
+	`, name, name)
+}
+
+func testAccResourceIngestPipelineReferences(name string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_ingest_pipeline" "child" {
+  name = "%s-child"
+
+  processors = [
+    jsonencode({
+      set = {
+        field = "processed"
+        value = "true"
+      }
+    })
+  ]
+}
+
+resource "elasticstack_elasticsearch_ingest_pipeline" "main" {
+  name = "%s"
+
+  processors = [
+    jsonencode({
+      pipeline = {
+        name = elasticstack_elasticsearch_ingest_pipeline.child.name
+      }
+    })
+  ]
+}
+	`, name, name)
+}
+
 func checkResourceIngestPipelineDestroy(s *terraform.State) error {
 	client, err := clients.NewAcceptanceTestingClient()
 	if err != nil {
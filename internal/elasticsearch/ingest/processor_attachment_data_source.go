@@ -0,0 +1,184 @@
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceProcessorAttachment() *schema.Resource {
+	processorSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"field": {
+			Description: "The field to get the base64 encoded field from.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"target_field": {
+			Description: "The field that will hold the attachment information, `attachment` by default.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"indexed_chars": {
+			Description:  "The number of chars being used for extraction to prevent huge fields, `100000` by default. Use `-1` for no limit.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(-1),
+		},
+		"indexed_chars_field": {
+			Description: "Field name from which you can overwrite the number of chars being used for extraction.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"properties": {
+			Description: "Array of properties to select to be stored, e.g. `content`, `title`, `author`, `keywords`, `date`, `content_type`, `content_length` or `language`.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"remove_binary": {
+			Description: "If `true`, the binary `field` will be removed from the document.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"resource_name": {
+			Description: "Field containing the name of the resource to decode. If specified, the processor passes this resource name to the underlying Tika library to enable Resource Name Based Detection.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"ignore_missing": {
+			Description: "If `true` and `field` does not exist, the processor quietly exits without modifying the document.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"description": {
+			Description: "Description of the processor. ",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"if": {
+			Description: "Conditionally execute the processor",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"ignore_failure": {
+			Description: "Ignore failures for the processor. ",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"on_failure": {
+			Description: "Handle failures for the processor.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: utils.DiffJsonSuppress,
+			},
+		},
+		"tag": {
+			Description: "Identifier for the processor.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"json": {
+			Description: "JSON representation of this data source.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "The attachment processor lets Elasticsearch extract file attachments in common formats (such as PPT, XLS, and PDF) by using the Apache text extraction library Tika. See: https://www.elastic.co/guide/en/elasticsearch/reference/current/attachment.html",
+
+		ReadContext: dataSourceProcessorAttachmentRead,
+
+		Schema: processorSchema,
+	}
+}
+
+func dataSourceProcessorAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	processor := &models.ProcessorAttachment{}
+
+	processor.IgnoreFailure = d.Get("ignore_failure").(bool)
+
+	processor.Field = d.Get("field").(string)
+	processor.IgnoreMissing = d.Get("ignore_missing").(bool)
+
+	if v, ok := d.GetOk("target_field"); ok {
+		processor.TargetField = v.(string)
+	}
+	if v, ok := d.GetOk("indexed_chars"); ok {
+		chars := v.(int)
+		processor.IndexedChars = &chars
+	}
+	if v, ok := d.GetOk("indexed_chars_field"); ok {
+		processor.IndexedCharsField = v.(string)
+	}
+	if v, ok := d.GetOk("properties"); ok {
+		processor.Properties = utils.ExpandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("remove_binary"); ok {
+		remove := v.(bool)
+		processor.RemoveBinary = &remove
+	}
+	if v, ok := d.GetOk("resource_name"); ok {
+		processor.ResourceName = v.(string)
+	}
+	if v, ok := d.GetOk("description"); ok {
+		processor.Description = v.(string)
+	}
+	if v, ok := d.GetOk("if"); ok {
+		processor.If = v.(string)
+	}
+	if v, ok := d.GetOk("tag"); ok {
+		processor.Tag = v.(string)
+	}
+	if v, ok := d.GetOk("on_failure"); ok {
+		onFailure := make([]map[string]interface{}, len(v.([]interface{})))
+		for i, f := range v.([]interface{}) {
+			item := make(map[string]interface{})
+			if err := json.NewDecoder(strings.NewReader(f.(string))).Decode(&item); err != nil {
+				return diag.FromErr(err)
+			}
+			onFailure[i] = item
+		}
+		processor.OnFailure = onFailure
+	}
+
+	processorJson, err := json.MarshalIndent(map[string]*models.ProcessorAttachment{"attachment": processor}, "", " ")
+	if err != nil {
+		diag.FromErr(err)
+	}
+	if err := d.Set("json", string(processorJson)); err != nil {
+		diag.FromErr(err)
+	}
+
+	hash, err := utils.StringToHash(string(processorJson))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*hash)
+
+	return diags
+}
@@ -0,0 +1,48 @@
+package ingest_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIngestProcessorAttachment(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIngestProcessorAttachment,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_processor_attachment.test", "field", "data"),
+					CheckResourceJson("data.elasticstack_elasticsearch_ingest_processor_attachment.test", "json", expectedJsonAttachment),
+				),
+			},
+		},
+	})
+}
+
+const expectedJsonAttachment = `{
+	"attachment": {
+		"field": "data",
+		"ignore_failure": false,
+		"ignore_missing": false,
+		"indexed_chars": 11,
+		"properties": ["content"],
+		"remove_binary": true
+	}
+}`
+
+const testAccDataSourceIngestProcessorAttachment = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_attachment" "test" {
+  field         = "data"
+  indexed_chars = 11
+  properties    = ["content"]
+  remove_binary = true
+}
+`
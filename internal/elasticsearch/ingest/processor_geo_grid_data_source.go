@@ -0,0 +1,184 @@
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceProcessorGeoGrid() *schema.Resource {
+	processorSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"field": {
+			Description: "The field to interpret as a geo-tile.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"tile_type": {
+			Description:  "Three tile formats are understood: `geohash`, `geotile` and `geohex`.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"geohash", "geotile", "geohex"}, false),
+		},
+		"target_field": {
+			Description: "The field to assign the polygon shape to, by default `field` is updated in-place.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"parent_field": {
+			Description: "If specified and a parent tile exists, save that tile address to this field.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"children_field": {
+			Description: "If specified and children tiles exist, save those tile addresses to this field as an array of strings.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"non_children_field": {
+			Description: "If specified and intersecting non-child tiles exist, save their addresses to this field as an array of strings.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"precision_field": {
+			Description: "If specified, save the tile precision (zoom) as an integer to this field.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"target_format": {
+			Description:  "Which format to save the generated polygon in: `geojson` or `wkt`, `geojson` by default.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"geojson", "wkt"}, true),
+		},
+		"ignore_missing": {
+			Description: "If `true` and `field` does not exist, the processor quietly exits without modifying the document.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"description": {
+			Description: "Description of the processor. ",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"if": {
+			Description: "Conditionally execute the processor",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"ignore_failure": {
+			Description: "Ignore failures for the processor. ",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"on_failure": {
+			Description: "Handle failures for the processor.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: utils.DiffJsonSuppress,
+			},
+		},
+		"tag": {
+			Description: "Identifier for the processor.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"json": {
+			Description: "JSON representation of this data source.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Converts geo-grid definitions of grid tiles or cells to regular bounding boxes or polygons which describe their shape. See: https://www.elastic.co/guide/en/elasticsearch/reference/current/ingest-geo-grid-processor.html",
+
+		ReadContext: dataSourceProcessorGeoGridRead,
+
+		Schema: processorSchema,
+	}
+}
+
+func dataSourceProcessorGeoGridRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	processor := &models.ProcessorGeoGrid{}
+
+	processor.IgnoreFailure = d.Get("ignore_failure").(bool)
+
+	processor.Field = d.Get("field").(string)
+	processor.TileType = d.Get("tile_type").(string)
+	processor.IgnoreMissing = d.Get("ignore_missing").(bool)
+
+	if v, ok := d.GetOk("target_field"); ok {
+		processor.TargetField = v.(string)
+	}
+	if v, ok := d.GetOk("parent_field"); ok {
+		processor.ParentField = v.(string)
+	}
+	if v, ok := d.GetOk("children_field"); ok {
+		processor.ChildrenField = v.(string)
+	}
+	if v, ok := d.GetOk("non_children_field"); ok {
+		processor.NonChildrenField = v.(string)
+	}
+	if v, ok := d.GetOk("precision_field"); ok {
+		processor.PrecisionField = v.(string)
+	}
+	if v, ok := d.GetOk("target_format"); ok {
+		processor.TargetFormat = v.(string)
+	}
+	if v, ok := d.GetOk("description"); ok {
+		processor.Description = v.(string)
+	}
+	if v, ok := d.GetOk("if"); ok {
+		processor.If = v.(string)
+	}
+	if v, ok := d.GetOk("tag"); ok {
+		processor.Tag = v.(string)
+	}
+	if v, ok := d.GetOk("on_failure"); ok {
+		onFailure := make([]map[string]interface{}, len(v.([]interface{})))
+		for i, f := range v.([]interface{}) {
+			item := make(map[string]interface{})
+			if err := json.NewDecoder(strings.NewReader(f.(string))).Decode(&item); err != nil {
+				return diag.FromErr(err)
+			}
+			onFailure[i] = item
+		}
+		processor.OnFailure = onFailure
+	}
+
+	processorJson, err := json.MarshalIndent(map[string]*models.ProcessorGeoGrid{"geo_grid": processor}, "", " ")
+	if err != nil {
+		diag.FromErr(err)
+	}
+	if err := d.Set("json", string(processorJson)); err != nil {
+		diag.FromErr(err)
+	}
+
+	hash, err := utils.StringToHash(string(processorJson))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*hash)
+
+	return diags
+}
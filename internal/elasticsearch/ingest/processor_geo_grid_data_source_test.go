@@ -0,0 +1,49 @@
+package ingest_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIngestProcessorGeoGrid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIngestProcessorGeoGrid,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_processor_geo_grid.test", "field", "geocell"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_processor_geo_grid.test", "tile_type", "geohex"),
+					CheckResourceJson("data.elasticstack_elasticsearch_ingest_processor_geo_grid.test", "json", expectedJsonGeoGrid),
+				),
+			},
+		},
+	})
+}
+
+const expectedJsonGeoGrid = `{
+	"geo_grid": {
+		"field": "geocell",
+		"tile_type": "geohex",
+		"target_field": "grid",
+		"parent_field": "parent",
+		"ignore_failure": false,
+		"ignore_missing": false
+	}
+}`
+
+const testAccDataSourceIngestProcessorGeoGrid = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_geo_grid" "test" {
+  field        = "geocell"
+  tile_type    = "geohex"
+  target_field = "grid"
+  parent_field = "parent"
+}
+`
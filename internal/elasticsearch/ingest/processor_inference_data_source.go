@@ -0,0 +1,195 @@
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceProcessorInference() *schema.Resource {
+	processorSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"model_id": {
+			Description: "The ID or alias for the trained model, or the ID of the deployment.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"target_field": {
+			Description:   "Field added to incoming documents to contain results objects, `ml.inference.<processor_tag>` by default.",
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"input_output"},
+		},
+		"field_map": {
+			Description:      "Maps the document field names to the known field names of the model as JSON. This mapping takes precedence over any default mappings provided in the model configuration.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+			ConflictsWith:    []string{"input_output"},
+		},
+		"input_output": {
+			Description: "Input fields for inference and output (destination) fields for the inference results. Supported only starting from Elasticsearch version **8.11**",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MinItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"input_field": {
+						Description: "The field name from which the inference processor reads its input value.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"output_field": {
+						Description: "The field name to which the inference processor writes its output.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"inference_config": {
+			Description:      "Contains the inference type and its options as JSON.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"ignore_missing": {
+			Description: "If `true` and any of the input fields defined in `input_output` are missing, those missing fields are quietly ignored. Supported only starting from Elasticsearch version **8.11**",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"description": {
+			Description: "Description of the processor. ",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"if": {
+			Description: "Conditionally execute the processor",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"ignore_failure": {
+			Description: "Ignore failures for the processor. ",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"on_failure": {
+			Description: "Handle failures for the processor.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: utils.DiffJsonSuppress,
+			},
+		},
+		"tag": {
+			Description: "Identifier for the processor.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"json": {
+			Description: "JSON representation of this data source.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Uses a pre-trained data frame analytics model or a model deployed for natural language processing tasks to infer against the data that is being ingested in the pipeline. See: https://www.elastic.co/guide/en/elasticsearch/reference/current/inference-processor.html",
+
+		ReadContext: dataSourceProcessorInferenceRead,
+
+		Schema: processorSchema,
+	}
+}
+
+func dataSourceProcessorInferenceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	processor := &models.ProcessorInference{}
+
+	processor.IgnoreFailure = d.Get("ignore_failure").(bool)
+
+	processor.ModelId = d.Get("model_id").(string)
+	processor.IgnoreMissing = d.Get("ignore_missing").(bool)
+
+	if v, ok := d.GetOk("target_field"); ok {
+		processor.TargetField = v.(string)
+	}
+	if v, ok := d.GetOk("field_map"); ok {
+		fieldMap := make(map[string]interface{})
+		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&fieldMap); err != nil {
+			return diag.FromErr(err)
+		}
+		processor.FieldMap = fieldMap
+	}
+	if v, ok := d.GetOk("input_output"); ok {
+		inputOutput := make([]models.InferenceInputOutput, len(v.([]interface{})))
+		for i, io := range v.([]interface{}) {
+			item := io.(map[string]interface{})
+			inputOutput[i].InputField = item["input_field"].(string)
+			inputOutput[i].OutputField = item["output_field"].(string)
+		}
+		processor.InputOutput = inputOutput
+	}
+	if v, ok := d.GetOk("inference_config"); ok {
+		config := make(map[string]interface{})
+		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&config); err != nil {
+			return diag.FromErr(err)
+		}
+		processor.InferenceConfig = config
+	}
+	if v, ok := d.GetOk("description"); ok {
+		processor.Description = v.(string)
+	}
+	if v, ok := d.GetOk("if"); ok {
+		processor.If = v.(string)
+	}
+	if v, ok := d.GetOk("tag"); ok {
+		processor.Tag = v.(string)
+	}
+	if v, ok := d.GetOk("on_failure"); ok {
+		onFailure := make([]map[string]interface{}, len(v.([]interface{})))
+		for i, f := range v.([]interface{}) {
+			item := make(map[string]interface{})
+			if err := json.NewDecoder(strings.NewReader(f.(string))).Decode(&item); err != nil {
+				return diag.FromErr(err)
+			}
+			onFailure[i] = item
+		}
+		processor.OnFailure = onFailure
+	}
+
+	processorJson, err := json.MarshalIndent(map[string]*models.ProcessorInference{"inference": processor}, "", " ")
+	if err != nil {
+		diag.FromErr(err)
+	}
+	if err := d.Set("json", string(processorJson)); err != nil {
+		diag.FromErr(err)
+	}
+
+	hash, err := utils.StringToHash(string(processorJson))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*hash)
+
+	return diags
+}
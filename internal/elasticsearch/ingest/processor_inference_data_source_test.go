@@ -0,0 +1,51 @@
+package ingest_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIngestProcessorInference(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIngestProcessorInference,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_processor_inference.test", "model_id", "lang_ident_model_1"),
+					CheckResourceJson("data.elasticstack_elasticsearch_ingest_processor_inference.test", "json", expectedJsonInference),
+				),
+			},
+		},
+	})
+}
+
+const expectedJsonInference = `{
+	"inference": {
+		"model_id": "lang_ident_model_1",
+		"input_output": [
+			{
+				"input_field": "content",
+				"output_field": "content_language"
+			}
+		],
+		"ignore_failure": false
+	}
+}`
+
+const testAccDataSourceIngestProcessorInference = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_inference" "test" {
+  model_id = "lang_ident_model_1"
+  input_output {
+    input_field  = "content"
+    output_field = "content_language"
+  }
+}
+`
@@ -0,0 +1,173 @@
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceProcessorIpLocation() *schema.Resource {
+	processorSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"field": {
+			Description: "The field to get the IP address from for the geographical lookup.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"target_field": {
+			Description: "The field that will hold the geographical information looked up from the database, `ip_location` by default.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"database_file": {
+			Description: "The database filename referring to one of the automatically downloaded GeoLite2 databases or a custom database in the `ingest-geoip` config directory, `GeoLite2-City.mmdb` by default.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"properties": {
+			Description: "Controls what properties are added to the `target_field` based on the lookup.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"first_only": {
+			Description: "If `true` only first found location data will be returned, even if field contains array.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"download_database_on_pipeline_creation": {
+			Description: "If `true`, the missing database is downloaded when the pipeline is created. Else, the download is triggered by when the pipeline is used as the `default_pipeline` or `final_pipeline` in an index.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"ignore_missing": {
+			Description: "If `true` and `field` does not exist, the processor quietly exits without modifying the document.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"description": {
+			Description: "Description of the processor. ",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"if": {
+			Description: "Conditionally execute the processor",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"ignore_failure": {
+			Description: "Ignore failures for the processor. ",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"on_failure": {
+			Description: "Handle failures for the processor.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: utils.DiffJsonSuppress,
+			},
+		},
+		"tag": {
+			Description: "Identifier for the processor.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"json": {
+			Description: "JSON representation of this data source.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "The ip_location processor adds information about the geographical location of an IPv4 or IPv6 address, using the MaxMind or IPinfo databases. See: https://www.elastic.co/guide/en/elasticsearch/reference/current/ip-location-processor.html",
+
+		ReadContext: dataSourceProcessorIpLocationRead,
+
+		Schema: processorSchema,
+	}
+}
+
+func dataSourceProcessorIpLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	processor := &models.ProcessorIpLocation{}
+
+	processor.IgnoreFailure = d.Get("ignore_failure").(bool)
+
+	processor.Field = d.Get("field").(string)
+	processor.IgnoreMissing = d.Get("ignore_missing").(bool)
+	processor.FirstOnly = d.Get("first_only").(bool)
+
+	if v, ok := d.GetOk("target_field"); ok {
+		processor.TargetField = v.(string)
+	}
+	if v, ok := d.GetOk("database_file"); ok {
+		processor.DatabaseFile = v.(string)
+	}
+	if v, ok := d.GetOk("properties"); ok {
+		processor.Properties = utils.ExpandStringSet(v.(*schema.Set))
+	}
+	// the database is downloaded on the pipeline creation by default
+	if download := d.Get("download_database_on_pipeline_creation").(bool); !download {
+		processor.DownloadDatabaseOnPipelineCreation = &download
+	}
+	if v, ok := d.GetOk("description"); ok {
+		processor.Description = v.(string)
+	}
+	if v, ok := d.GetOk("if"); ok {
+		processor.If = v.(string)
+	}
+	if v, ok := d.GetOk("tag"); ok {
+		processor.Tag = v.(string)
+	}
+	if v, ok := d.GetOk("on_failure"); ok {
+		onFailure := make([]map[string]interface{}, len(v.([]interface{})))
+		for i, f := range v.([]interface{}) {
+			item := make(map[string]interface{})
+			if err := json.NewDecoder(strings.NewReader(f.(string))).Decode(&item); err != nil {
+				return diag.FromErr(err)
+			}
+			onFailure[i] = item
+		}
+		processor.OnFailure = onFailure
+	}
+
+	processorJson, err := json.MarshalIndent(map[string]*models.ProcessorIpLocation{"ip_location": processor}, "", " ")
+	if err != nil {
+		diag.FromErr(err)
+	}
+	if err := d.Set("json", string(processorJson)); err != nil {
+		diag.FromErr(err)
+	}
+
+	hash, err := utils.StringToHash(string(processorJson))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*hash)
+
+	return diags
+}
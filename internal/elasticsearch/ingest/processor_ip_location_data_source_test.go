@@ -0,0 +1,45 @@
+package ingest_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIngestProcessorIpLocation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIngestProcessorIpLocation,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_processor_ip_location.test", "field", "ip"),
+					CheckResourceJson("data.elasticstack_elasticsearch_ingest_processor_ip_location.test", "json", expectedJsonIpLocation),
+				),
+			},
+		},
+	})
+}
+
+const expectedJsonIpLocation = `{
+	"ip_location": {
+		"field": "ip",
+		"first_only": true,
+		"download_database_on_pipeline_creation": false,
+		"ignore_failure": false,
+		"ignore_missing": false
+	}
+}`
+
+const testAccDataSourceIngestProcessorIpLocation = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_ip_location" "test" {
+  field                                  = "ip"
+  download_database_on_pipeline_creation = false
+}
+`
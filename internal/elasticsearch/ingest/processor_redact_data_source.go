@@ -0,0 +1,190 @@
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceProcessorRedact() *schema.Resource {
+	processorSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"field": {
+			Description: "The field to be redacted.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"patterns": {
+			Description: "A list of grok expressions to match and redact named captures with.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"pattern_definitions": {
+			Description: "A map of pattern-name and pattern tuples defining custom patterns to be used by the processor.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"prefix": {
+			Description: "Start a redacted section with this token, `<` by default.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"suffix": {
+			Description: "End a redacted section with this token, `>` by default.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"skip_if_unlicensed": {
+			Description: "If `true` and the current license does not support running redact processors, then the processor quietly exits without modifying the document.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"trace_redact": {
+			Description: "If `true` then ingest metadata `_ingest._redact._is_redacted` is set to `true` if the document has been redacted. Supported only starting from Elasticsearch version **8.16**",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"ignore_missing": {
+			Description: "If `true` and `field` does not exist, the processor quietly exits without modifying the document.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"description": {
+			Description: "Description of the processor. ",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"if": {
+			Description: "Conditionally execute the processor",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"ignore_failure": {
+			Description: "Ignore failures for the processor. ",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"on_failure": {
+			Description: "Handle failures for the processor.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: utils.DiffJsonSuppress,
+			},
+		},
+		"tag": {
+			Description: "Identifier for the processor.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"json": {
+			Description: "JSON representation of this data source.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "The redact processor uses the Grok rules engine to obscure text in the input document matching the given Grok patterns. See: https://www.elastic.co/guide/en/elasticsearch/reference/current/redact-processor.html",
+
+		ReadContext: dataSourceProcessorRedactRead,
+
+		Schema: processorSchema,
+	}
+}
+
+func dataSourceProcessorRedactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	processor := &models.ProcessorRedact{}
+
+	processor.IgnoreFailure = d.Get("ignore_failure").(bool)
+
+	processor.Field = d.Get("field").(string)
+	processor.IgnoreMissing = d.Get("ignore_missing").(bool)
+	processor.SkipIfUnlicensed = d.Get("skip_if_unlicensed").(bool)
+	processor.TraceRedact = d.Get("trace_redact").(bool)
+
+	pats := d.Get("patterns").([]interface{})
+	patterns := make([]string, len(pats))
+	for i, v := range pats {
+		patterns[i] = v.(string)
+	}
+	processor.Patterns = patterns
+
+	if v, ok := d.GetOk("pattern_definitions"); ok {
+		pd := v.(map[string]interface{})
+		defs := make(map[string]string, len(pd))
+		for k, p := range pd {
+			defs[k] = p.(string)
+		}
+		processor.PatternDefinitions = defs
+	}
+	if v, ok := d.GetOk("prefix"); ok {
+		processor.Prefix = v.(string)
+	}
+	if v, ok := d.GetOk("suffix"); ok {
+		processor.Suffix = v.(string)
+	}
+	if v, ok := d.GetOk("description"); ok {
+		processor.Description = v.(string)
+	}
+	if v, ok := d.GetOk("if"); ok {
+		processor.If = v.(string)
+	}
+	if v, ok := d.GetOk("tag"); ok {
+		processor.Tag = v.(string)
+	}
+	if v, ok := d.GetOk("on_failure"); ok {
+		onFailure := make([]map[string]interface{}, len(v.([]interface{})))
+		for i, f := range v.([]interface{}) {
+			item := make(map[string]interface{})
+			if err := json.NewDecoder(strings.NewReader(f.(string))).Decode(&item); err != nil {
+				return diag.FromErr(err)
+			}
+			onFailure[i] = item
+		}
+		processor.OnFailure = onFailure
+	}
+
+	processorJson, err := json.MarshalIndent(map[string]*models.ProcessorRedact{"redact": processor}, "", " ")
+	if err != nil {
+		diag.FromErr(err)
+	}
+	if err := d.Set("json", string(processorJson)); err != nil {
+		diag.FromErr(err)
+	}
+
+	hash, err := utils.StringToHash(string(processorJson))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*hash)
+
+	return diags
+}
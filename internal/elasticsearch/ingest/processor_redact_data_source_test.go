@@ -0,0 +1,54 @@
+package ingest_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIngestProcessorRedact(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIngestProcessorRedact,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ingest_processor_redact.test", "field", "message"),
+					CheckResourceJson("data.elasticstack_elasticsearch_ingest_processor_redact.test", "json", expectedJsonRedact),
+				),
+			},
+		},
+	})
+}
+
+const expectedJsonRedact = `{
+	"redact": {
+		"field": "message",
+		"patterns": ["%{EMAILADDRESS:EMAIL}", "%{ZIP:ZIP}"],
+		"pattern_definitions": {
+			"ZIP": "\\d{5}"
+		},
+		"prefix": "*",
+		"suffix": "*",
+		"ignore_failure": false,
+		"ignore_missing": false
+	}
+}`
+
+const testAccDataSourceIngestProcessorRedact = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_redact" "test" {
+  field    = "message"
+  patterns = ["%%{EMAILADDRESS:EMAIL}", "%%{ZIP:ZIP}"]
+  pattern_definitions = {
+    ZIP = "\\d{5}"
+  }
+  prefix = "*"
+  suffix = "*"
+}
+`
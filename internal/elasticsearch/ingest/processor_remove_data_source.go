@@ -20,10 +20,21 @@ func DataSourceProcessorRemove() *schema.Resource {
 			Computed:    true,
 		},
 		"field": {
-			Description: "Fields to be removed.",
-			Type:        schema.TypeSet,
-			Required:    true,
-			MinItems:    1,
+			Description:  "Fields to be removed.",
+			Type:         schema.TypeSet,
+			Optional:     true,
+			MinItems:     1,
+			ExactlyOneOf: []string{"field", "keep"},
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"keep": {
+			Description:  "Fields to be kept. When set, all fields other than those specified are removed. Supported only starting from Elasticsearch version **8.4**",
+			Type:         schema.TypeSet,
+			Optional:     true,
+			MinItems:     1,
+			ExactlyOneOf: []string{"field", "keep"},
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
@@ -90,12 +101,12 @@ func dataSourceProcessorRemoveRead(ctx context.Context, d *schema.ResourceData,
 	processor.IgnoreFailure = d.Get("ignore_failure").(bool)
 	processor.IgnoreMissing = d.Get("ignore_missing").(bool)
 
-	fields := d.Get("field").(*schema.Set)
-	flds := make([]string, fields.Len())
-	for i, f := range fields.List() {
-		flds[i] = f.(string)
+	if v, ok := d.GetOk("field"); ok {
+		processor.Field = utils.ExpandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("keep"); ok {
+		processor.Keep = utils.ExpandStringSet(v.(*schema.Set))
 	}
-	processor.Field = flds
 
 	if v, ok := d.GetOk("description"); ok {
 		processor.Description = v.(string)
@@ -18,6 +18,12 @@ func TestAccDataSourceIngestProcessorRemove(t *testing.T) {
 					CheckResourceJson("data.elasticstack_elasticsearch_ingest_processor_remove.test", "json", expectedJsonRemove),
 				),
 			},
+			{
+				Config: testAccDataSourceIngestProcessorRemoveKeep,
+				Check: resource.ComposeTestCheckFunc(
+					CheckResourceJson("data.elasticstack_elasticsearch_ingest_processor_remove.test", "json", expectedJsonRemoveKeep),
+				),
+			},
 		},
 	})
 }
@@ -39,3 +45,21 @@ data "elasticstack_elasticsearch_ingest_processor_remove" "test" {
   field = ["user_agent"]
 }
 `
+
+const expectedJsonRemoveKeep = `{
+	"remove": {
+		"keep": ["message"],
+		"ignore_failure": false,
+		"ignore_missing": false
+	}
+}`
+
+const testAccDataSourceIngestProcessorRemoveKeep = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_remove" "test" {
+  keep = ["message"]
+}
+`
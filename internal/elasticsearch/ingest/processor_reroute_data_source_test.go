@@ -0,0 +1,44 @@
+package ingest_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIngestProcessorReroute(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIngestProcessorReroute,
+				Check: resource.ComposeTestCheckFunc(
+					CheckResourceJson("data.elasticstack_elasticsearch_ingest_processor_reroute.test", "json", expectedJsonReroute),
+				),
+			},
+		},
+	})
+}
+
+const expectedJsonReroute = `{
+	"reroute": {
+		"dataset": ["{{service.name}}", "generic"],
+		"namespace": ["default"],
+		"if": "ctx.service?.name != null",
+		"ignore_failure": false
+	}
+}`
+
+const testAccDataSourceIngestProcessorReroute = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_reroute" "test" {
+  dataset   = ["{{service.name}}", "generic"]
+  namespace = ["default"]
+  if        = "ctx.service?.name != null"
+}
+`
@@ -45,6 +45,24 @@ func DataSourceProcessorScript() *schema.Resource {
 			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: utils.DiffJsonSuppress,
 		},
+		"params_string": {
+			Description: "String parameters for the script, merged with `params`.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"params_number": {
+			Description: "Number parameters for the script, merged with `params`.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeFloat},
+		},
+		"params_bool": {
+			Description: "Boolean parameters for the script, merged with `params`.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeBool},
+		},
 		"description": {
 			Description: "Description of the processor. ",
 			Type:        schema.TypeString,
@@ -109,11 +127,21 @@ func dataSourceProcessorScriptRead(ctx context.Context, d *schema.ResourceData,
 	if v, ok := d.GetOk("source"); ok {
 		processor.Source = v.(string)
 	}
+	params := make(map[string]interface{})
 	if v, ok := d.GetOk("params"); ok {
-		params := make(map[string]interface{})
 		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&params); err != nil {
 			return diag.FromErr(err)
 		}
+	}
+	for _, key := range []string{"params_string", "params_number", "params_bool"} {
+		for name, value := range d.Get(key).(map[string]interface{}) {
+			if _, ok := params[name]; ok {
+				return diag.Errorf(`the script parameter "%s" is set more than once`, name)
+			}
+			params[name] = value
+		}
+	}
+	if len(params) > 0 {
 		processor.Params = params
 	}
 	if v, ok := d.GetOk("description"); ok {
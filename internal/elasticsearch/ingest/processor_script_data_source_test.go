@@ -18,6 +18,12 @@ func TestAccDataSourceIngestProcessorScript(t *testing.T) {
 					CheckResourceJson("data.elasticstack_elasticsearch_ingest_processor_script.test", "json", expectedJsonScript),
 				),
 			},
+			{
+				Config: testAccDataSourceIngestProcessorScriptTypedParams,
+				Check: resource.ComposeTestCheckFunc(
+					CheckResourceJson("data.elasticstack_elasticsearch_ingest_processor_script.test", "json", expectedJsonScriptTypedParams),
+				),
+			},
 		},
 	})
 }
@@ -58,3 +64,39 @@ EOF
 
 }
 `
+
+const expectedJsonScriptTypedParams = `{
+	"script": {
+		"ignore_failure": false,
+		"params": {
+			"delimiter": "-",
+			"position": 1,
+			"trim": true,
+			"extra": {"a": [1, 2]}
+		},
+		"source": "ctx['tags'] = ctx['env'].splitOnToken(params['delimiter']);"
+	}
+}`
+
+const testAccDataSourceIngestProcessorScriptTypedParams = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_script" "test" {
+  source = "ctx['tags'] = ctx['env'].splitOnToken(params['delimiter']);"
+
+  params_string = {
+    delimiter = "-"
+  }
+  params_number = {
+    position = 1
+  }
+  params_bool = {
+    trim = true
+  }
+  params = jsonencode({
+    extra = { a = [1, 2] }
+  })
+}
+`
@@ -0,0 +1,40 @@
+package ingest_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIngestProcessorTerminate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIngestProcessorTerminate,
+				Check: resource.ComposeTestCheckFunc(
+					CheckResourceJson("data.elasticstack_elasticsearch_ingest_processor_terminate.test", "json", expectedJsonTerminate),
+				),
+			},
+		},
+	})
+}
+
+const expectedJsonTerminate = `{
+	"terminate": {
+		"if": "ctx.error != null",
+		"ignore_failure": false
+	}
+}`
+
+const testAccDataSourceIngestProcessorTerminate = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ingest_processor_terminate" "test" {
+  if = "ctx.error != null"
+}
+`
@@ -3,20 +3,28 @@ package security
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
 	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var APIKeyMinVersion = version.Must(version.NewVersion("8.0.0")) // Enabled in 8.0
+var (
+	APIKeyMinVersion                 = version.Must(version.NewVersion("8.0.0"))  // Enabled in 8.0
+	APIKeyUpdateMinVersion           = version.Must(version.NewVersion("8.4.0"))  // Update API key is available since 8.4
+	APIKeyUpdateExpirationMinVersion = version.Must(version.NewVersion("8.13.0")) // Expiration can be updated since 8.13
+)
 
 func ResourceApiKey() *schema.Resource {
 	apikeySchema := map[string]*schema.Schema{
@@ -36,18 +44,16 @@ func ResourceApiKey() *schema.Resource {
 			),
 		},
 		"role_descriptors": {
-			Description:      "Role descriptors for this API key.",
+			Description:      "Role descriptors for this API key. Updated in place on Elasticsearch 8.4 and newer, the API key is re-created on the older versions.",
 			Type:             schema.TypeString,
 			Optional:         true,
-			ForceNew:         true,
 			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: utils.DiffJsonSuppress,
 		},
 		"expiration": {
-			Description: "Expiration time for the API key. By default, API keys never expire.",
+			Description: "Expiration time for the API key. By default, API keys never expire. Updated in place on Elasticsearch 8.13 and newer, the API key is re-created on the older versions or when the expiration is removed.",
 			Type:        schema.TypeString,
 			Optional:    true,
-			ForceNew:    true,
 		},
 		"expiration_timestamp": {
 			Description: "Expiration time in milliseconds for the API key. By default, API keys never expire.",
@@ -55,14 +61,98 @@ func ResourceApiKey() *schema.Resource {
 			Computed:    true,
 		},
 		"metadata": {
-			Description:      "Arbitrary metadata that you want to associate with the API key.",
+			Description:      "Arbitrary metadata that you want to associate with the API key. Updated in place on Elasticsearch 8.4 and newer, the API key is re-created on the older versions.",
 			Type:             schema.TypeString,
 			Optional:         true,
 			Computed:         true,
-			ForceNew:         true,
 			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: utils.DiffJsonSuppress,
 		},
+		"grant": {
+			Description: "Creates the API key on behalf of another user with the grant API key API. The provider user must have the `grant_api_key` cluster privilege. The API key is owned by the granted user, so any change of `role_descriptors`, `metadata` or `expiration` re-creates it. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-grant-api-key.html",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"grant_type": {
+						Description:  "The type of the grant, either `password` or `access_token`.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice([]string{"password", "access_token"}, false),
+					},
+					"username": {
+						Description: "The user name that identifies the user. Required for the `password` grant type, ignored otherwise.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						ForceNew:    true,
+					},
+					"password": {
+						Description: "The user's password. Required for the `password` grant type. Only used to create the API key, changing it does not re-create the key.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"access_token": {
+						Description: "The user's access token. Required for the `access_token` grant type. Only used to create the API key, changing it does not re-create the key.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"run_as": {
+						Description: "The name of the user to be impersonated.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						ForceNew:    true,
+					},
+				},
+			},
+		},
+		"rotation": {
+			Description: "Rotates the API key on a schedule. When the rotation is due, the next apply creates a new API key and keeps the previous one valid for the overlap window, so the consumers can be switched to the new key. The previous key is invalidated by the first apply after the overlap window.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rotate_after": {
+						Description:  "How long after its creation the API key is rotated, e.g. `720h`.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: utils.ValidateDuration,
+					},
+					"keep_previous_for": {
+						Description:  "How long the previous API key stays valid after the rotation, e.g. `24h`. Set to `0s` to invalidate the previous key immediately.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "24h",
+						ValidateFunc: utils.ValidateDuration,
+					},
+				},
+			},
+		},
+		"creation_timestamp": {
+			Description: "Creation time in milliseconds of the current API key.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"previous_id": {
+			Description: "ID of the previous API key, which is still valid after the rotation.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"previous_encoded": {
+			Description: "API key credentials of the previous API key, which is still valid after the rotation.",
+			Type:        schema.TypeString,
+			Sensitive:   true,
+			Computed:    true,
+		},
+		"previous_invalidation_timestamp": {
+			Description: "Time in milliseconds after which the previous API key is invalidated by the next apply.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
 		"api_key": {
 			Description: "Generated API Key.",
 			Type:        schema.TypeString,
@@ -87,16 +177,74 @@ func ResourceApiKey() *schema.Resource {
 		ReadContext:   resourceSecurityApiKeyRead,
 		DeleteContext: resourceSecurityApiKeyDelete,
 
+		CustomizeDiff: customdiff.All(
+			customizeApiKeyDiff,
+			customizeApiKeyRotationDiff,
+		),
+
 		Schema: apikeySchema,
 	}
 }
 
+// The API key can be updated in place only on the newer versions of Elasticsearch, otherwise it must be re-created
+func customizeApiKeyDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	changed := make([]string, 0)
+	for _, key := range []string{"role_descriptors", "metadata", "expiration"} {
+		if d.HasChange(key) {
+			changed = append(changed, key)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	client, diags := clients.NewApiClientFromDiff(d, meta)
+	if diags.HasError() {
+		return fmt.Errorf("unable to create the Elasticsearch client: %v", diags)
+	}
+	serverVersion, diags := client.ServerVersion(ctx)
+	if diags.HasError() {
+		return fmt.Errorf("unable to get the Elasticsearch version: %v", diags)
+	}
+
+	// the granted API key is owned by another user and cannot be updated with the provider credentials
+	_, granted := d.GetOk("grant")
+	for _, key := range changed {
+		forceNew := granted || serverVersion.LessThan(APIKeyUpdateMinVersion)
+		if key == "expiration" {
+			// the expiration cannot be removed from the existing API key
+			forceNew = forceNew || serverVersion.LessThan(APIKeyUpdateExpirationMinVersion) || d.Get(key).(string) == ""
+		}
+		if forceNew {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		} else if key == "expiration" {
+			if err := d.SetNewComputed("expiration_timestamp"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func resourceSecurityApiKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClient(d, meta)
 	if diags.HasError() {
 		return diags
 	}
 
+	if diags := createApiKey(ctx, client, d); diags.HasError() {
+		return diags
+	}
+	return resourceSecurityApiKeyRead(ctx, d, meta)
+}
+
+// Creates the new API key with the current configuration and sets its credentials and ID
+func createApiKey(ctx context.Context, client *clients.ApiClient, d *schema.ResourceData) diag.Diagnostics {
 	nameId := d.Get("name").(string)
 
 	var apikey models.ApiKey
@@ -106,27 +254,38 @@ func resourceSecurityApiKeyCreate(ctx context.Context, d *schema.ResourceData, m
 		apikey.Expiration = v.(string)
 	}
 
-	if v, ok := d.GetOk("role_descriptors"); ok {
-		role_descriptors := map[string]models.Role{}
-		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&role_descriptors); err != nil {
-			return diag.FromErr(err)
-		}
-		apikey.RolesDescriptors = role_descriptors
+	roleDescriptors, diags := expandApiKeyRoleDescriptors(d)
+	if diags.HasError() {
+		return diags
 	}
-
-	if v, ok := d.GetOk("metadata"); ok {
-		metadata := make(map[string]interface{})
-		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&metadata); err != nil {
-			return diag.FromErr(err)
-		}
-		apikey.Metadata = metadata
+	if len(roleDescriptors) > 0 {
+		apikey.RolesDescriptors = roleDescriptors
 	}
 
-	putResponse, diags := elasticsearch.PutApiKey(client, &apikey)
-
+	metadata, diags := expandApiKeyMetadata(d)
 	if diags.HasError() {
 		return diags
 	}
+	if len(metadata) > 0 {
+		apikey.Metadata = metadata
+	}
+
+	var putResponse *models.ApiKeyResponse
+	if _, ok := d.GetOk("grant"); ok {
+		grant, diags := expandApiKeyGrant(d, apikey)
+		if diags.HasError() {
+			return diags
+		}
+		putResponse, diags = elasticsearch.GrantApiKey(ctx, client, grant)
+		if diags.HasError() {
+			return diags
+		}
+	} else {
+		putResponse, diags = elasticsearch.PutApiKey(client, &apikey)
+		if diags.HasError() {
+			return diags
+		}
+	}
 
 	id, diags := client.ID(ctx, putResponse.Id)
 	if diags.HasError() {
@@ -152,17 +311,192 @@ func resourceSecurityApiKeyCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	d.SetId(id.String())
-	return resourceSecurityApiKeyRead(ctx, d, meta)
+	return diags
+}
+
+func expandApiKeyGrant(d *schema.ResourceData, apikey models.ApiKey) (*models.ApiKeyGrant, diag.Diagnostics) {
+	grant := models.ApiKeyGrant{
+		GrantType:   d.Get("grant.0.grant_type").(string),
+		Username:    d.Get("grant.0.username").(string),
+		Password:    d.Get("grant.0.password").(string),
+		AccessToken: d.Get("grant.0.access_token").(string),
+		RunAs:       d.Get("grant.0.run_as").(string),
+		ApiKey:      apikey,
+	}
+	switch grant.GrantType {
+	case "password":
+		if grant.Username == "" || grant.Password == "" {
+			return nil, diag.Errorf("'username' and 'password' are required for the 'password' grant type")
+		}
+		grant.AccessToken = ""
+	case "access_token":
+		if grant.AccessToken == "" {
+			return nil, diag.Errorf("'access_token' is required for the 'access_token' grant type")
+		}
+		grant.Username = ""
+		grant.Password = ""
+	}
+	return &grant, nil
 }
 
+func expandApiKeyRoleDescriptors(d *schema.ResourceData) (map[string]models.Role, diag.Diagnostics) {
+	roleDescriptors := map[string]models.Role{}
+	if v, ok := d.GetOk("role_descriptors"); ok {
+		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&roleDescriptors); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+	return roleDescriptors, nil
+}
+
+func expandApiKeyMetadata(d *schema.ResourceData) (map[string]interface{}, diag.Diagnostics) {
+	metadata := make(map[string]interface{})
+	if v, ok := d.GetOk("metadata"); ok {
+		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&metadata); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+	return metadata, nil
+}
+
+// Marks the ID and the credentials as changing when the API key must be rotated or the previous key must be invalidated
+func customizeApiKeyRotationDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	now := time.Now()
+	if isApiKeyRotationDue(d, now) {
+		// the rotated key replaces the current one, so its ID changes as well
+		for _, key := range []string{"id", "api_key", "encoded", "expiration_timestamp", "creation_timestamp", "previous_id", "previous_encoded", "previous_invalidation_timestamp"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if isPreviousApiKeyExpired(d, now) {
+		for _, key := range []string{"previous_id", "previous_encoded", "previous_invalidation_timestamp"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type resourceGetter interface {
+	Get(string) interface{}
+}
+
+func isApiKeyRotationDue(d resourceGetter, now time.Time) bool {
+	rotation := d.Get("rotation").([]interface{})
+	if len(rotation) == 0 || rotation[0] == nil {
+		return false
+	}
+	rotateAfter, err := time.ParseDuration(rotation[0].(map[string]interface{})["rotate_after"].(string))
+	if err != nil {
+		return false
+	}
+	creation := d.Get("creation_timestamp").(int)
+	return creation > 0 && !now.Before(time.UnixMilli(int64(creation)).Add(rotateAfter))
+}
+
+func isPreviousApiKeyExpired(d resourceGetter, now time.Time) bool {
+	invalidation := d.Get("previous_invalidation_timestamp").(int)
+	return d.Get("previous_id").(string) != "" && !now.Before(time.UnixMilli(int64(invalidation)))
+}
+
+// Creates the new API key and keeps the current one as the previous key for the overlap window
+func rotateApiKey(ctx context.Context, client *clients.ApiClient, d *schema.ResourceData, currentId string) diag.Diagnostics {
+	// only one previous key is kept, the older one is invalidated even if its overlap window has not passed yet
+	if previousId := d.Get("previous_id").(string); previousId != "" {
+		if diags := elasticsearch.DeleteApiKey(client, previousId); diags.HasError() {
+			return diags
+		}
+	}
+	currentEncoded, _ := d.GetChange("encoded")
+
+	if diags := createApiKey(ctx, client, d); diags.HasError() {
+		return diags
+	}
+	tflog.Info(ctx, fmt.Sprintf(`API key "%s" has been rotated`, currentId))
+
+	keepFor, err := time.ParseDuration(d.Get("rotation.0.keep_previous_for").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if keepFor <= 0 {
+		if diags := elasticsearch.DeleteApiKey(client, currentId); diags.HasError() {
+			return diags
+		}
+		return setPreviousApiKey(d, "", "", 0)
+	}
+	return setPreviousApiKey(d, currentId, currentEncoded.(string), time.Now().Add(keepFor).UnixMilli())
+}
+
+func setPreviousApiKey(d *schema.ResourceData, id, encoded string, invalidation int64) diag.Diagnostics {
+	if err := d.Set("previous_id", id); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("previous_encoded", encoded); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("previous_invalidation_timestamp", invalidation); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// The update is only called on the supported versions of Elasticsearch, otherwise the changes force the new resource
 func resourceSecurityApiKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	diags := diag.Diagnostics{diag.Diagnostic{
-		Severity: diag.Error,
-		Summary:  `Cannot update API Key`,
-		Detail:   `update not currently supported.`,
-	}}
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
 
-	return diags
+	now := time.Now()
+	if isApiKeyRotationDue(d, now) {
+		// the new key is created with the current configuration, so there is nothing left to update
+		if diags := rotateApiKey(ctx, client, d, compId.ResourceId); diags.HasError() {
+			return diags
+		}
+		return resourceSecurityApiKeyRead(ctx, d, meta)
+	}
+	if isPreviousApiKeyExpired(d, now) {
+		if diags := elasticsearch.DeleteApiKey(client, d.Get("previous_id").(string)); diags.HasError() {
+			return diags
+		}
+		if diags := setPreviousApiKey(d, "", "", 0); diags.HasError() {
+			return diags
+		}
+	}
+	if !d.HasChanges("role_descriptors", "metadata", "expiration") {
+		return resourceSecurityApiKeyRead(ctx, d, meta)
+	}
+
+	// the role descriptors and metadata are always sent, since the empty values reset them
+	var apikey models.ApiKeyUpdate
+	apikey.RolesDescriptors, diags = expandApiKeyRoleDescriptors(d)
+	if diags.HasError() {
+		return diags
+	}
+	apikey.Metadata, diags = expandApiKeyMetadata(d)
+	if diags.HasError() {
+		return diags
+	}
+	if d.HasChange("expiration") {
+		apikey.Expiration = d.Get("expiration").(string)
+	}
+
+	if diags := elasticsearch.UpdateApiKey(ctx, client, compId.ResourceId, &apikey); diags.HasError() {
+		return diags
+	}
+
+	return resourceSecurityApiKeyRead(ctx, d, meta)
 }
 
 func resourceSecurityApiKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -197,6 +531,9 @@ func resourceSecurityApiKeyRead(ctx context.Context, d *schema.ResourceData, met
 	if err := d.Set("expiration_timestamp", apikey.Expiration); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("creation_timestamp", apikey.Creation); err != nil {
+		return diag.FromErr(err)
+	}
 
 	if apikey.RolesDescriptors != nil {
 		rolesDescriptors, err := json.Marshal(apikey.RolesDescriptors)
@@ -228,6 +565,11 @@ func resourceSecurityApiKeyDelete(ctx context.Context, d *schema.ResourceData, m
 	if diags := elasticsearch.DeleteApiKey(client, compId.ResourceId); diags.HasError() {
 		return diags
 	}
+	if previousId := d.Get("previous_id").(string); previousId != "" {
+		if diags := elasticsearch.DeleteApiKey(client, previousId); diags.HasError() {
+			return diags
+		}
+	}
 
 	d.SetId("")
 	return diags
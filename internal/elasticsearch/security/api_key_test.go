@@ -64,6 +64,127 @@ func TestAccResourceSecuritApiKey(t *testing.T) {
 	})
 }
 
+func TestAccResourceSecuritApiKeyUpdate(t *testing.T) {
+	// generate a random name
+	apiKeyName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+	var encoded string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityApiKeyDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.APIKeyUpdateMinVersion),
+				Config:   testAccResourceSecuritApiKeyCreate(apiKeyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_api_key.test", "encoded", func(value string) error {
+						encoded = value
+						return nil
+					}),
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.APIKeyUpdateMinVersion),
+				Config:   testAccResourceSecuritApiKeyUpdate(apiKeyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "name", apiKeyName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "metadata", `{"env":"test"}`),
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_api_key.test", "role_descriptors", func(testValue string) error {
+						var testRoleDescriptor map[string]models.Role
+						if err := json.Unmarshal([]byte(testValue), &testRoleDescriptor); err != nil {
+							return err
+						}
+						if privileges := testRoleDescriptor["role-a"].Indices[0].Privileges; !reflect.DeepEqual(privileges, []string{"read", "view_index_metadata"}) {
+							return fmt.Errorf("unexpected index privileges %v", privileges)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_api_key.test", "encoded", func(value string) error {
+						if value != encoded {
+							return fmt.Errorf("API key was re-created instead of being updated")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecuritApiKeyRotation(t *testing.T) {
+	// generate a random name
+	apiKeyName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+	var encoded string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityApiKeyDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.APIKeyMinVersion),
+				Config:   testAccResourceSecuritApiKeyRotation(apiKeyName),
+				// the rotation is already due when the plan is checked after the apply
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "previous_id", ""),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_api_key.test", "creation_timestamp"),
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_api_key.test", "encoded", func(value string) error {
+						encoded = value
+						return nil
+					}),
+				),
+			},
+			{
+				SkipFunc:           versionutils.CheckIfVersionIsUnsupported(security.APIKeyMinVersion),
+				Config:             testAccResourceSecuritApiKeyRotation(apiKeyName),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_api_key.test", "previous_id"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_api_key.test", "previous_invalidation_timestamp"),
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_api_key.test", "previous_encoded", func(value string) error {
+						if value != encoded {
+							return fmt.Errorf("expected previous_encoded to hold the rotated key")
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("elasticstack_elasticsearch_security_api_key.test", "encoded", func(value string) error {
+						if value == encoded {
+							return fmt.Errorf("API key has not been rotated")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecuritApiKeyGrant(t *testing.T) {
+	// generate a random name
+	apiKeyName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityApiKeyDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.APIKeyMinVersion),
+				Config:   testAccResourceSecuritApiKeyGrant(apiKeyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "name", apiKeyName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "grant.0.grant_type", "password"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_api_key.test", "grant.0.username", apiKeyName),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_api_key.test", "api_key"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_api_key.test", "encoded"),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourceSecuritApiKeyCreate(apiKeyName string) string {
 	return fmt.Sprintf(`
 provider "elasticstack" {
@@ -89,6 +210,78 @@ resource "elasticstack_elasticsearch_security_api_key" "test" {
 	`, apiKeyName)
 }
 
+func testAccResourceSecuritApiKeyUpdate(apiKeyName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_api_key" "test" {
+  name = "%s"
+
+  role_descriptors = jsonencode({
+    role-a = {
+      cluster = ["all"]
+      indices = [{
+        names = ["index-a*"]
+        privileges = ["read", "view_index_metadata"]
+        allow_restricted_indices = false
+      }]
+    }
+  })
+
+  metadata = jsonencode({
+    env = "test"
+  })
+
+  expiration = "1d"
+}
+	`, apiKeyName)
+}
+
+func testAccResourceSecuritApiKeyRotation(apiKeyName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_api_key" "test" {
+  name = "%s"
+
+  rotation {
+    rotate_after      = "1s"
+    keep_previous_for = "1h"
+  }
+}
+	`, apiKeyName)
+}
+
+func testAccResourceSecuritApiKeyGrant(apiKeyName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_user" "test" {
+  username = "%[1]s"
+  roles    = ["viewer"]
+  password = "qwerty123"
+}
+
+resource "elasticstack_elasticsearch_security_api_key" "test" {
+  name = "%[1]s"
+
+  grant {
+    grant_type = "password"
+    username   = elasticstack_elasticsearch_security_user.test.username
+    password   = elasticstack_elasticsearch_security_user.test.password
+  }
+
+  expiration = "1d"
+}
+	`, apiKeyName)
+}
+
 func checkResourceSecurityApiKeyDestroy(s *terraform.State) error {
 	client, err := clients.NewAcceptanceTestingClient()
 	if err != nil {
@@ -96,7 +289,7 @@ func checkResourceSecurityApiKeyDestroy(s *terraform.State) error {
 	}
 
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "elasticstack_elasticsearch_security_api_key" {
+		if rs.Type != "elasticstack_elasticsearch_security_api_key" && rs.Type != "elasticstack_elasticsearch_security_cross_cluster_api_key" {
 			continue
 		}
 		compId, _ := clients.CompositeIdFromStr(rs.Primary.ID)
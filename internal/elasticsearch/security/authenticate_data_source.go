@@ -0,0 +1,185 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceAuthenticate() *schema.Resource {
+	realmSchema := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Description: description,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The name of the realm.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"type": {
+						Description: "The type of the realm.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		}
+	}
+
+	authenticateSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"username": {
+			Description: "The name of the authenticated user.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"full_name": {
+			Description: "The full name of the authenticated user.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"email": {
+			Description: "The email of the authenticated user.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"roles": {
+			Description: "The roles of the authenticated user.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"metadata": {
+			Description: "The metadata of the authenticated user.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"enabled": {
+			Description: "Whether the authenticated user is enabled.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"authentication_realm": realmSchema("The realm, which authenticated the user."),
+		"lookup_realm":         realmSchema("The realm, from which the user was looked up."),
+		"authentication_type": {
+			Description: "The type of the authentication, e.g. `realm`, `api_key`, `token` or `anonymous`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"api_key": {
+			Description: "The API key used for the authentication, set only when `authentication_type` is `api_key`.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The ID of the API key.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "The name of the API key.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(authenticateSchema)
+
+	return &schema.Resource{
+		Description: "Retrieves the information about the user authenticated by the provider. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-authenticate.html",
+
+		ReadContext: dataSourceSecurityAuthenticateRead,
+
+		Schema: authenticateSchema,
+	}
+}
+
+func dataSourceSecurityAuthenticateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	authentication, diags := elasticsearch.Authenticate(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+	id, diags := client.ID(ctx, authentication.Username)
+	if diags.HasError() {
+		return diags
+	}
+
+	metadata, err := json.Marshal(authentication.Metadata)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("username", authentication.Username); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("full_name", authentication.FullName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("email", authentication.Email); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("roles", authentication.Roles); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metadata", string(metadata)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled", authentication.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("authentication_realm", flattenRealm(authentication.AuthenticationRealm)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("lookup_realm", flattenRealm(authentication.LookupRealm)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("authentication_type", authentication.AuthenticationType); err != nil {
+		return diag.FromErr(err)
+	}
+	apiKey := make([]interface{}, 0)
+	if authentication.ApiKey != nil {
+		apiKey = append(apiKey, map[string]interface{}{
+			"id":   authentication.ApiKey.Id,
+			"name": authentication.ApiKey.Name,
+		})
+	}
+	if err := d.Set("api_key", apiKey); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
+
+func flattenRealm(realm models.Realm) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"name": realm.Name,
+			"type": realm.Type,
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package security_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityAuthenticate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecurityAuthenticate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_security_authenticate.test", "username"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_authenticate.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_authenticate.test", "authentication_realm.#", "1"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_security_authenticate.test", "authentication_realm.0.name"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_security_authenticate.test", "authentication_type"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceSecurityAuthenticate = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_authenticate" "test" {}
+`
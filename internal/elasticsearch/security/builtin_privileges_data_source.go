@@ -0,0 +1,73 @@
+package security
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceBuiltinPrivileges() *schema.Resource {
+	privilegesSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"cluster": {
+			Description: "The list of the cluster privileges understood by this version of Elasticsearch.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"index": {
+			Description: "The list of the index privileges understood by this version of Elasticsearch.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(privilegesSchema)
+
+	return &schema.Resource{
+		Description: "Retrieves the list of cluster privileges and index privileges that are available in this version of Elasticsearch. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-get-builtin-privileges.html",
+
+		ReadContext: dataSourceSecurityBuiltinPrivilegesRead,
+
+		Schema: privilegesSchema,
+	}
+}
+
+func dataSourceSecurityBuiltinPrivilegesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	id, diags := client.ID(ctx, "builtin-privileges")
+	if diags.HasError() {
+		return diags
+	}
+
+	privileges, diags := elasticsearch.GetBuiltinPrivileges(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("cluster", privileges.Cluster); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("index", privileges.Index); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
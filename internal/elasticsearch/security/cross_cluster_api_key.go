@@ -0,0 +1,385 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var CrossClusterApiKeyMinVersion = version.Must(version.NewVersion("8.10.0")) // Enabled in 8.10
+
+func ResourceCrossClusterApiKey() *schema.Resource {
+	apikeySchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Specifies the name for this API key.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 1024),
+				validation.StringMatch(regexp.MustCompile(`^([[:graph:]]| )+$`), "must contain alphanumeric characters (a-z, A-Z, 0-9), spaces, punctuation, and printable symbols in the Basic Latin (ASCII) block. Leading or trailing whitespace is not allowed"),
+			),
+		},
+		"access": {
+			Description: "The access to be granted to this API key. At least one of `search` or `replication` must be specified.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"search": {
+						Description:  "A list of indices permission entries for cross-cluster search.",
+						Type:         schema.TypeList,
+						Optional:     true,
+						AtLeastOneOf: []string{"access.0.search", "access.0.replication"},
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"names": {
+									Description: "A list of indices or name patterns to which the permissions in this entry apply.",
+									Type:        schema.TypeSet,
+									Required:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"field_security": {
+									Description: "The document fields that the owners of the API key have read access to.",
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"grant": {
+												Description: "List of the fields to grant the access to.",
+												Type:        schema.TypeSet,
+												Optional:    true,
+												Elem: &schema.Schema{
+													Type: schema.TypeString,
+												},
+											},
+											"except": {
+												Description: "List of the fields to which the grants will not be applied.",
+												Type:        schema.TypeSet,
+												Optional:    true,
+												Elem: &schema.Schema{
+													Type: schema.TypeString,
+												},
+											},
+										},
+									},
+								},
+								"query": {
+									Description:      "A search query that defines the documents the owners of the API key have read access to.",
+									Type:             schema.TypeString,
+									Optional:         true,
+									ValidateFunc:     validation.StringIsJSON,
+									DiffSuppressFunc: utils.DiffJsonSuppress,
+								},
+								"allow_restricted_indices": {
+									Description: "Include matching restricted indices in names parameter. Usage is strongly discouraged as it can grant unrestricted operations on critical data, make the entire system unstable or leak sensitive information.",
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Default:     false,
+								},
+							},
+						},
+					},
+					"replication": {
+						Description:  "A list of indices permission entries for cross-cluster replication.",
+						Type:         schema.TypeList,
+						Optional:     true,
+						AtLeastOneOf: []string{"access.0.search", "access.0.replication"},
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"names": {
+									Description: "A list of indices or name patterns to which the permissions in this entry apply.",
+									Type:        schema.TypeSet,
+									Required:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"expiration": {
+			Description: "Expiration time for the API key. By default, API keys never expire.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"expiration_timestamp": {
+			Description: "Expiration time in milliseconds for the API key. By default, API keys never expire.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"metadata": {
+			Description:      "Arbitrary metadata that you want to associate with the API key.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"api_key": {
+			Description: "Generated API Key.",
+			Type:        schema.TypeString,
+			Sensitive:   true,
+			Computed:    true,
+		},
+		"encoded": {
+			Description: "API key credentials which is the Base64-encoding of the UTF-8 representation of the id and api_key joined by a colon (:).",
+			Type:        schema.TypeString,
+			Sensitive:   true,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(apikeySchema)
+
+	return &schema.Resource{
+		Description: "Creates an API key of the `cross_cluster` type, which is used by the remote clusters to access the local cluster. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-create-cross-cluster-api-key.html",
+
+		CreateContext: resourceSecurityCrossClusterApiKeyCreate,
+		UpdateContext: resourceSecurityCrossClusterApiKeyUpdate,
+		ReadContext:   resourceSecurityCrossClusterApiKeyRead,
+		DeleteContext: resourceSecurityCrossClusterApiKeyDelete,
+
+		Schema: apikeySchema,
+	}
+}
+
+func resourceSecurityCrossClusterApiKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	serverVersion, diags := client.ServerVersion(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	if serverVersion.LessThan(CrossClusterApiKeyMinVersion) {
+		return diag.Errorf("cross-cluster API keys are supported only for Elasticsearch v%s and above", CrossClusterApiKeyMinVersion.String())
+	}
+
+	var apikey models.CrossClusterApiKey
+	apikey.Name = d.Get("name").(string)
+	apikey.Expiration = d.Get("expiration").(string)
+	apikey.Access, diags = expandCrossClusterApiKeyAccess(d.Get("access").([]interface{}))
+	if diags.HasError() {
+		return diags
+	}
+	apikey.Metadata, diags = expandApiKeyMetadata(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	putResponse, diags := elasticsearch.PutCrossClusterApiKey(ctx, client, &apikey)
+	if diags.HasError() {
+		return diags
+	}
+
+	id, diags := client.ID(ctx, putResponse.Id)
+	if diags.HasError() {
+		return diags
+	}
+	if err := d.Set("api_key", putResponse.Key); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("encoded", putResponse.EncodedKey); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return resourceSecurityCrossClusterApiKeyRead(ctx, d, meta)
+}
+
+func resourceSecurityCrossClusterApiKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	var apikey models.CrossClusterApiKeyUpdate
+	apikey.Access, diags = expandCrossClusterApiKeyAccess(d.Get("access").([]interface{}))
+	if diags.HasError() {
+		return diags
+	}
+	apikey.Metadata, diags = expandApiKeyMetadata(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags := elasticsearch.UpdateCrossClusterApiKey(ctx, client, compId.ResourceId, &apikey); diags.HasError() {
+		return diags
+	}
+	return resourceSecurityCrossClusterApiKeyRead(ctx, d, meta)
+}
+
+func expandCrossClusterApiKeyAccess(definedAccess []interface{}) (models.CrossClusterApiKeyAccess, diag.Diagnostics) {
+	var access models.CrossClusterApiKeyAccess
+	if len(definedAccess) == 0 || definedAccess[0] == nil {
+		return access, nil
+	}
+	a := definedAccess[0].(map[string]interface{})
+
+	for _, s := range a["search"].([]interface{}) {
+		search := s.(map[string]interface{})
+		indices := models.CrossClusterApiKeyIndices{
+			Names: utils.ExpandStringSet(search["names"].(*schema.Set)),
+		}
+		if query := search["query"].(string); query != "" {
+			q := make(map[string]interface{})
+			if err := json.NewDecoder(strings.NewReader(query)).Decode(&q); err != nil {
+				return access, diag.FromErr(err)
+			}
+			indices.Query = q
+		}
+		if fieldSec := search["field_security"].([]interface{}); len(fieldSec) > 0 && fieldSec[0] != nil {
+			definedFieldSec := fieldSec[0].(map[string]interface{})
+			indices.FieldSecurity = &models.FieldSecurity{
+				Grant:  utils.ExpandStringSet(definedFieldSec["grant"].(*schema.Set)),
+				Except: utils.ExpandStringSet(definedFieldSec["except"].(*schema.Set)),
+			}
+		}
+		if allowRestrictedIndices := search["allow_restricted_indices"].(bool); allowRestrictedIndices {
+			indices.AllowRestrictedIndices = &allowRestrictedIndices
+		}
+		access.Search = append(access.Search, indices)
+	}
+
+	for _, r := range a["replication"].([]interface{}) {
+		replication := r.(map[string]interface{})
+		access.Replication = append(access.Replication, models.CrossClusterApiKeyIndices{
+			Names: utils.ExpandStringSet(replication["names"].(*schema.Set)),
+		})
+	}
+	return access, nil
+}
+
+func resourceSecurityCrossClusterApiKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	apikey, diags := elasticsearch.GetApiKey(client, compId.ResourceId)
+	if apikey == nil && diags == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`Cross-cluster API key "%s" not found, removing from state`, compId.ResourceId))
+		d.SetId("")
+		return diags
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("name", apikey.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("expiration_timestamp", apikey.Expiration); err != nil {
+		return diag.FromErr(err)
+	}
+	if apikey.Access != nil {
+		access, diags := flattenCrossClusterApiKeyAccess(apikey.Access)
+		if diags.HasError() {
+			return diags
+		}
+		if err := d.Set("access", access); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	metadata, err := json.Marshal(apikey.Metadata)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metadata", string(metadata)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func flattenCrossClusterApiKeyAccess(access *models.CrossClusterApiKeyAccess) ([]interface{}, diag.Diagnostics) {
+	search := make([]interface{}, len(access.Search))
+	for i, indices := range access.Search {
+		s := make(map[string]interface{})
+		s["names"] = indices.Names
+		s["allow_restricted_indices"] = indices.AllowRestrictedIndices != nil && *indices.AllowRestrictedIndices
+		switch q := indices.Query.(type) {
+		case nil:
+			s["query"] = ""
+		case string:
+			s["query"] = q
+		default:
+			query, err := json.Marshal(q)
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+			s["query"] = string(query)
+		}
+		if indices.FieldSecurity != nil {
+			s["field_security"] = []interface{}{map[string]interface{}{
+				"grant":  indices.FieldSecurity.Grant,
+				"except": indices.FieldSecurity.Except,
+			}}
+		}
+		search[i] = s
+	}
+
+	replication := make([]interface{}, len(access.Replication))
+	for i, indices := range access.Replication {
+		replication[i] = map[string]interface{}{"names": indices.Names}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"search":      search,
+		"replication": replication,
+	}}, nil
+}
+
+func resourceSecurityCrossClusterApiKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	compId, diags := clients.CompositeIdFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags := elasticsearch.DeleteApiKey(client, compId.ResourceId); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return diags
+}
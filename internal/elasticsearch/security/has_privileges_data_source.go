@@ -0,0 +1,231 @@
+package security
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceHasPrivileges() *schema.Resource {
+	privilegesMapSchema := &schema.Schema{
+		Description: "The results of the check, keyed by the privilege name.",
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeBool,
+		},
+	}
+
+	hasPrivilegesSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"cluster": {
+			Description: "A list of the cluster privileges to check.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"index": {
+			Description: "A list of the index privileges to check.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"names": {
+						Description: "A list of indices.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"privileges": {
+						Description: "A list of the privileges to check for the specified indices.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"allow_restricted_indices": {
+						Description: "Whether to check the privileges on the restricted indices matched by the `names`.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+		"application": {
+			Description: "A list of the application privileges to check.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"application": {
+						Description: "The name of the application.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"privileges": {
+						Description: "A list of the application privileges or actions to check.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"resources": {
+						Description: "A list of the resources to check the privileges against.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+		"username": {
+			Description: "The name of the user, whose privileges were checked.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"has_all_requested": {
+			Description: "Whether the user has all the requested privileges.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"cluster_results": privilegesMapSchema,
+		"index_results": {
+			Description: "The results of the index privileges check.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The name of the index.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"privileges": privilegesMapSchema,
+				},
+			},
+		},
+		"application_results": {
+			Description: "The results of the application privileges check.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"application": {
+						Description: "The name of the application.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"resource": {
+						Description: "The name of the resource.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"privileges": privilegesMapSchema,
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(hasPrivilegesSchema)
+
+	return &schema.Resource{
+		Description: "Checks whether the user authenticated by the provider has the specified list of privileges. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-has-privileges.html",
+
+		ReadContext: dataSourceSecurityHasPrivilegesRead,
+
+		Schema: hasPrivilegesSchema,
+	}
+}
+
+func dataSourceSecurityHasPrivilegesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	var request models.HasPrivilegesRequest
+	request.Cluster = utils.ExpandStringSet(d.Get("cluster").(*schema.Set))
+	for _, idx := range d.Get("index").([]interface{}) {
+		index := idx.(map[string]interface{})
+		allowRestrictedIndices := index["allow_restricted_indices"].(bool)
+		request.Index = append(request.Index, models.HasPrivilegesIndex{
+			Names:                  utils.ExpandStringSet(index["names"].(*schema.Set)),
+			Privileges:             utils.ExpandStringSet(index["privileges"].(*schema.Set)),
+			AllowRestrictedIndices: &allowRestrictedIndices,
+		})
+	}
+	for _, a := range d.Get("application").([]interface{}) {
+		app := a.(map[string]interface{})
+		request.Application = append(request.Application, models.Application{
+			Name:       app["application"].(string),
+			Privileges: utils.ExpandStringSet(app["privileges"].(*schema.Set)),
+			Resources:  utils.ExpandStringSet(app["resources"].(*schema.Set)),
+		})
+	}
+
+	privileges, diags := elasticsearch.HasPrivileges(ctx, client, &request)
+	if diags.HasError() {
+		return diags
+	}
+	id, diags := client.ID(ctx, privileges.Username)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set("username", privileges.Username); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("has_all_requested", privileges.HasAllRequested); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("cluster_results", privileges.Cluster); err != nil {
+		return diag.FromErr(err)
+	}
+
+	indexResults := make([]interface{}, 0, len(privileges.Index))
+	for _, name := range utils.SortedKeys(privileges.Index) {
+		indexResults = append(indexResults, map[string]interface{}{
+			"name":       name,
+			"privileges": privileges.Index[name],
+		})
+	}
+	if err := d.Set("index_results", indexResults); err != nil {
+		return diag.FromErr(err)
+	}
+
+	applicationResults := make([]interface{}, 0)
+	for _, application := range utils.SortedKeys(privileges.Application) {
+		resources := privileges.Application[application]
+		for _, resource := range utils.SortedKeys(resources) {
+			applicationResults = append(applicationResults, map[string]interface{}{
+				"application": application,
+				"resource":    resource,
+				"privileges":  resources[resource],
+			})
+		}
+	}
+	if err := d.Set("application_results", applicationResults); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
@@ -0,0 +1,53 @@
+package security_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityHasPrivileges(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecurityHasPrivileges,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_security_has_privileges.test", "username"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.test", "has_all_requested", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.test", "cluster_results.monitor", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.test", "index_results.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.test", "index_results.0.name", "test-index-*"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.test", "index_results.0.privileges.read", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.test", "application_results.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.test", "application_results.0.application", "test-app"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.test", "application_results.0.resource", "*"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_has_privileges.test", "application_results.0.privileges.read", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceSecurityHasPrivileges = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_has_privileges" "test" {
+  cluster = ["monitor"]
+
+  index {
+    names      = ["test-index-*"]
+    privileges = ["read"]
+  }
+
+  application {
+    application = "test-app"
+    privileges  = ["read"]
+    resources   = ["*"]
+  }
+}
+`
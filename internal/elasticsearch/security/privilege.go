@@ -0,0 +1,191 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourcePrivilege() *schema.Resource {
+	privilegeSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"application": {
+			Description: "The name of the application to which the privilege belongs.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(3, 256),
+				validation.StringMatch(regexp.MustCompile(`^[a-z][a-zA-Z0-9]{2,}([-_][^\\/*?"<>|,\s]*)?$`), "must begin with a lowercase letter followed by at least two letters or digits, with an optional suffix starting with `-` or `_`"),
+			),
+		},
+		"name": {
+			Description: "The name of the privilege.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 256),
+				validation.StringMatch(regexp.MustCompile(`^[a-z][a-zA-Z0-9_.-]*$`), "must begin with a lowercase letter and contain only letters, digits, `_`, `-` and `.`"),
+			),
+		},
+		"actions": {
+			Description: "A list of the actions granted by the privilege. Each action must contain at least one of `/`, `*` or `:` characters.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`[/*:]`), "must contain at least one of `/`, `*` or `:` characters"),
+			},
+		},
+		"metadata": {
+			Description:      "Optional meta-data. Keys beginning with `_` are reserved for system usage.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "{}",
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+	}
+
+	utils.AddConnectionSchema(privilegeSchema)
+
+	return &schema.Resource{
+		Description: "Adds and updates application privileges. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-put-privileges.html",
+
+		CreateContext: resourceSecurityPrivilegePut,
+		UpdateContext: resourceSecurityPrivilegePut,
+		ReadContext:   resourceSecurityPrivilegeRead,
+		DeleteContext: resourceSecurityPrivilegeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: privilegeSchema,
+	}
+}
+
+// The resource identifier is in the `<application>:<name>` format, the privilege name cannot contain colons
+func privilegeFromResourceID(resourceID string) (string, string, diag.Diagnostics) {
+	sep := strings.LastIndex(resourceID, ":")
+	if sep <= 0 || sep == len(resourceID)-1 {
+		return "", "", diag.Errorf(`Wrong application privilege ID "%s", it must have the following format: <cluster_uuid>/<application>:<name>`, resourceID)
+	}
+	return resourceID[:sep], resourceID[sep+1:], nil
+}
+
+func resourceSecurityPrivilegePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	application := d.Get("application").(string)
+	name := d.Get("name").(string)
+	id, diags := client.ID(ctx, fmt.Sprintf("%s:%s", application, name))
+	if diags.HasError() {
+		return diags
+	}
+
+	privilege := models.ApplicationPrivilege{
+		Application: application,
+		Name:        name,
+		Actions:     utils.ExpandStringSet(d.Get("actions").(*schema.Set)),
+	}
+	metadata := make(map[string]interface{})
+	if err := json.NewDecoder(strings.NewReader(d.Get("metadata").(string))).Decode(&metadata); err != nil {
+		return diag.FromErr(err)
+	}
+	privilege.Metadata = metadata
+
+	if diags := elasticsearch.PutApplicationPrivilege(ctx, client, &privilege); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.String())
+	return resourceSecurityPrivilegeRead(ctx, d, meta)
+}
+
+func resourceSecurityPrivilegeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	resourceID, diags := clients.ResourceIDFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	application, name, diags := privilegeFromResourceID(resourceID)
+	if diags.HasError() {
+		return diags
+	}
+
+	privilege, diags := elasticsearch.GetApplicationPrivilege(ctx, client, application, name)
+	if diags.HasError() {
+		return diags
+	}
+	if privilege == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`Application privilege "%s" not found, removing from state`, resourceID))
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("application", application); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("actions", privilege.Actions); err != nil {
+		return diag.FromErr(err)
+	}
+	metadata := privilege.Metadata
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+	metadataBytes, err := json.Marshal(metadata)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metadata", string(metadataBytes)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceSecurityPrivilegeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	resourceID, diags := clients.ResourceIDFromStr(d.Id())
+	if diags.HasError() {
+		return diags
+	}
+	application, name, diags := privilegeFromResourceID(resourceID)
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags := elasticsearch.DeleteApplicationPrivilege(ctx, client, application, name); diags.HasError() {
+		return diags
+	}
+	return diags
+}
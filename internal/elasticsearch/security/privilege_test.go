@@ -0,0 +1,132 @@
+package security_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceSecurityPrivilege(t *testing.T) {
+	// generate a random application name
+	appName := "app" + sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityPrivilegeDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityPrivilegeCreate(appName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_privilege.read", "application", appName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_privilege.read", "name", "read"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_privilege.read", "actions.#", "1"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_privilege.read", "actions.*", "data:read/*"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_privilege.read", "metadata", "{}"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_role.test", "applications.*.privileges.*", "read"),
+				),
+			},
+			{
+				Config: testAccResourceSecurityPrivilegeUpdate(appName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_privilege.read", "actions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_privilege.read", "actions.*", "data:read/*"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_privilege.read", "actions.*", "action:login"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_privilege.read", "metadata", `{"description":"Read access"}`),
+				),
+			},
+			{
+				ResourceName:      "elasticstack_elasticsearch_security_privilege.read",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"elasticsearch_connection",
+				},
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityPrivilegeCreate(appName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_privilege" "read" {
+  application = "%s"
+  name        = "read"
+  actions     = ["data:read/*"]
+}
+
+resource "elasticstack_elasticsearch_security_role" "test" {
+  name = "%s"
+
+  applications {
+    application = elasticstack_elasticsearch_security_privilege.read.application
+    privileges  = [elasticstack_elasticsearch_security_privilege.read.name]
+    resources   = ["*"]
+  }
+}
+	`, appName, appName)
+}
+
+func testAccResourceSecurityPrivilegeUpdate(appName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_privilege" "read" {
+  application = "%s"
+  name        = "read"
+  actions     = ["data:read/*", "action:login"]
+
+  metadata = jsonencode({
+    description = "Read access"
+  })
+}
+
+resource "elasticstack_elasticsearch_security_role" "test" {
+  name = "%s"
+
+  applications {
+    application = elasticstack_elasticsearch_security_privilege.read.application
+    privileges  = [elasticstack_elasticsearch_security_privilege.read.name]
+    resources   = ["*"]
+  }
+}
+	`, appName, appName)
+}
+
+func checkResourceSecurityPrivilegeDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_security_privilege" {
+			continue
+		}
+		compId, _ := clients.CompositeIdFromStr(rs.Primary.ID)
+		sep := strings.LastIndex(compId.ResourceId, ":")
+
+		privilege, diags := elasticsearch.GetApplicationPrivilege(context.Background(), client, compId.ResourceId[:sep], compId.ResourceId[sep+1:])
+		if diags.HasError() {
+			return fmt.Errorf("Unable to get application privilege %v", diags)
+		}
+		if privilege != nil {
+			return fmt.Errorf("Application privilege (%s) still exists", compId.ResourceId)
+		}
+	}
+	return nil
+}
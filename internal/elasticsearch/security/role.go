@@ -4,18 +4,26 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
 	"github.com/elastic/terraform-provider-elasticstack/internal/models"
 	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var (
+	RoleRemoteIndicesMinVersion = version.Must(version.NewVersion("8.10.0"))
+	RoleRestrictionMinVersion   = version.Must(version.NewVersion("8.9.0"))
+	RoleDescriptionMinVersion   = version.Must(version.NewVersion("8.15.0"))
+)
+
 func ResourceRole() *schema.Resource {
 	roleSchema := map[string]*schema.Schema{
 		"id": {
@@ -29,6 +37,11 @@ func ResourceRole() *schema.Resource {
 			Required:    true,
 			ForceNew:    true,
 		},
+		"description": {
+			Description: "The description of the role. Supported from Elasticsearch version **8.15**.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
 		"applications": {
 			Description: "A list of application privilege entries.",
 			Type:        schema.TypeSet,
@@ -41,7 +54,7 @@ func ResourceRole() *schema.Resource {
 						Required:    true,
 					},
 					"privileges": {
-						Description: "A list of strings, where each element is the name of an application privilege or action.",
+						Description: "A list of strings, where each element is the name of an application privilege or action. The application privileges which do not exist in the cluster are reported as warnings after the apply.",
 						Type:        schema.TypeSet,
 						Elem: &schema.Schema{
 							Type: schema.TypeString,
@@ -137,6 +150,72 @@ func ResourceRole() *schema.Resource {
 				},
 			},
 		},
+		"remote_indices": {
+			Description: "A list of remote indices permissions entries. Supported from Elasticsearch version **8.10**.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"clusters": {
+						Description: "A list of remote cluster aliases (or cluster alias patterns) to which the permissions in this entry apply.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"field_security": {
+						Description: "The document fields that the owners of the role have read access to.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"grant": {
+									Description: "List of the fields to grant the access to.",
+									Type:        schema.TypeSet,
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+								"except": {
+									Description: "List of the fields to which the grants will not be applied.",
+									Type:        schema.TypeSet,
+									Optional:    true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+							},
+						},
+					},
+					"names": {
+						Description: "A list of indices (or index name patterns) on the remote clusters to which the permissions in this entry apply.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"privileges": {
+						Description: "The index level privileges that the owners of the role have on the specified remote indices.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"query": {
+						Description:      "A search query that defines the documents the owners of the role have read access to.",
+						Type:             schema.TypeString,
+						ValidateFunc:     validation.StringIsJSON,
+						DiffSuppressFunc: utils.DiffJsonSuppress,
+						Optional:         true,
+					},
+				},
+			},
+		},
 		"metadata": {
 			Description:      "Optional meta-data.",
 			Type:             schema.TypeString,
@@ -153,6 +232,24 @@ func ResourceRole() *schema.Resource {
 				Type: schema.TypeString,
 			},
 		},
+		"restriction": {
+			Description: "Restriction for when the role is allowed to be effective. Supported from Elasticsearch version **8.9**.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"workflows": {
+						Description: "A list of workflows to which the role is restricted, e.g. `search_application_query`.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
 	}
 
 	utils.AddConnectionSchema(roleSchema)
@@ -169,6 +266,8 @@ func ResourceRole() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeRolePrivilegesDiff,
+
 		Schema: roleSchema,
 	}
 }
@@ -183,8 +282,20 @@ func resourceSecurityRolePut(ctx context.Context, d *schema.ResourceData, meta i
 	if diags.HasError() {
 		return diags
 	}
+	serverVersion, diags := client.ServerVersion(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
 	var role models.Role
 	role.Name = roleId
+
+	if v, ok := d.GetOk("description"); ok {
+		if serverVersion.LessThan(RoleDescriptionMinVersion) {
+			return diag.Errorf("'description' is supported only for Elasticsearch v%s and above", RoleDescriptionMinVersion.String())
+		}
+		role.Description = v.(string)
+	}
 	if v, ok := d.GetOk("applications"); ok {
 		definedApps := v.(*schema.Set)
 		applications := make([]models.Application, definedApps.Len())
@@ -234,49 +345,7 @@ func resourceSecurityRolePut(ctx context.Context, d *schema.ResourceData, meta i
 		indices := make([]models.IndexPerms, definedIndices.Len())
 		for i, idx := range definedIndices.List() {
 			index := idx.(map[string]interface{})
-
-			definedNames := index["names"].(*schema.Set)
-			names := make([]string, definedNames.Len())
-			for i, name := range definedNames.List() {
-				names[i] = name.(string)
-			}
-			definedPrivs := index["privileges"].(*schema.Set)
-			privs := make([]string, definedPrivs.Len())
-			for i, pr := range definedPrivs.List() {
-				privs[i] = pr.(string)
-			}
-
-			newIndex := models.IndexPerms{
-				Names:      names,
-				Privileges: privs,
-			}
-
-			if query := index["query"].(string); query != "" {
-				newIndex.Query = &query
-			}
-			if fieldSec := index["field_security"].([]interface{}); len(fieldSec) > 0 {
-				fieldSecurity := models.FieldSecurity{}
-				// there must be only 1 entry
-				definedFieldSec := fieldSec[0].(map[string]interface{})
-
-				// grants
-				if gr := definedFieldSec["grant"].(*schema.Set); gr != nil {
-					grants := make([]string, gr.Len())
-					for i, grant := range gr.List() {
-						grants[i] = grant.(string)
-					}
-					fieldSecurity.Grant = grants
-				}
-				// except
-				if exp := definedFieldSec["except"].(*schema.Set); exp != nil {
-					excepts := make([]string, exp.Len())
-					for i, except := range exp.List() {
-						excepts[i] = except.(string)
-					}
-					fieldSecurity.Except = excepts
-				}
-				newIndex.FieldSecurity = &fieldSecurity
-			}
+			newIndex := expandIndexPerms(index)
 
 			allowRestrictedIndices := index["allow_restricted_indices"].(bool)
 			newIndex.AllowRestrictedIndices = &allowRestrictedIndices
@@ -286,6 +355,23 @@ func resourceSecurityRolePut(ctx context.Context, d *schema.ResourceData, meta i
 		role.Indices = indices
 	}
 
+	if v, ok := d.GetOk("remote_indices"); ok {
+		if serverVersion.LessThan(RoleRemoteIndicesMinVersion) {
+			return diag.Errorf("'remote_indices' is supported only for Elasticsearch v%s and above", RoleRemoteIndicesMinVersion.String())
+		}
+
+		definedIndices := v.(*schema.Set)
+		remoteIndices := make([]models.RemoteIndexPerms, definedIndices.Len())
+		for i, idx := range definedIndices.List() {
+			index := idx.(map[string]interface{})
+			remoteIndices[i] = models.RemoteIndexPerms{
+				IndexPerms: expandIndexPerms(index),
+				Clusters:   utils.ExpandStringSet(index["clusters"].(*schema.Set)),
+			}
+		}
+		role.RemoteIndices = remoteIndices
+	}
+
 	if v, ok := d.GetOk("metadata"); ok {
 		metadata := make(map[string]interface{})
 		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&metadata); err != nil {
@@ -303,12 +389,167 @@ func resourceSecurityRolePut(ctx context.Context, d *schema.ResourceData, meta i
 		role.RusAs = runs
 	}
 
+	if v, ok := d.GetOk("restriction"); ok {
+		if serverVersion.LessThan(RoleRestrictionMinVersion) {
+			return diag.Errorf("'restriction' is supported only for Elasticsearch v%s and above", RoleRestrictionMinVersion.String())
+		}
+		restriction := v.([]interface{})[0].(map[string]interface{})
+		role.Restriction = &models.RoleRestriction{
+			Workflows: utils.ExpandStringSet(restriction["workflows"].(*schema.Set)),
+		}
+	}
+
 	if diags := elasticsearch.PutRole(ctx, client, &role); diags.HasError() {
 		return diags
 	}
 
 	d.SetId(id.String())
-	return resourceSecurityRoleRead(ctx, d, meta)
+	// the diff cannot carry warnings, the application privileges are checked once the role is applied,
+	// at this point the privileges declared by the referenced elasticstack_elasticsearch_security_privilege resources already exist
+	var warnings diag.Diagnostics
+	if d.HasChange("applications") {
+		warnings = missingApplicationPrivilegesDiags(ctx, client, role.Applications)
+	}
+	return append(warnings, resourceSecurityRoleRead(ctx, d, meta)...)
+}
+
+// Validates the cluster and index privileges of the role against the builtin privileges of the cluster.
+// The privileges given as the action patterns are not checked.
+func customizeRolePrivilegesDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("cluster") && !d.HasChange("indices") && !d.HasChange("remote_indices") {
+		return nil
+	}
+	cluster := utils.ExpandStringSet(d.Get("cluster").(*schema.Set))
+	index := make([]string, 0)
+	for _, key := range []string{"indices", "remote_indices"} {
+		for _, idx := range d.Get(key).(*schema.Set).List() {
+			index = append(index, utils.ExpandStringSet(idx.(map[string]interface{})["privileges"].(*schema.Set))...)
+		}
+	}
+	if len(cluster) == 0 && len(index) == 0 {
+		return nil
+	}
+
+	client, diags := clients.NewApiClientFromDiff(d, meta)
+	if diags.HasError() {
+		return fmt.Errorf("unable to create the Elasticsearch client: %v", diags)
+	}
+	builtin, diags := elasticsearch.GetBuiltinPrivileges(ctx, client)
+	if diags.HasError() {
+		tflog.Warn(ctx, fmt.Sprintf("Unable to get the builtin privileges, skipping the validation of the role privileges: %v", diags))
+		return nil
+	}
+
+	var unknown []string
+	for _, privilege := range unknownPrivileges(cluster, builtin.Cluster) {
+		unknown = append(unknown, fmt.Sprintf("cluster privilege %q", privilege))
+	}
+	for _, privilege := range unknownPrivileges(index, builtin.Index) {
+		unknown = append(unknown, fmt.Sprintf("index privilege %q", privilege))
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown privileges: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// Returns the privileges, which are neither builtin nor the action patterns, nor unknown during the plan
+func unknownPrivileges(privileges, builtin []string) []string {
+	known := make(map[string]bool, len(builtin))
+	for _, privilege := range builtin {
+		known[privilege] = true
+	}
+	unknown := make([]string, 0)
+	for _, privilege := range privileges {
+		if privilege == "" || known[privilege] || strings.ContainsAny(privilege, "/*:") {
+			continue
+		}
+		unknown = append(unknown, privilege)
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// Returns the warnings for the application privileges, which do not exist in the cluster.
+// Actions and wildcards are not checked.
+func missingApplicationPrivilegesDiags(ctx context.Context, client *clients.ApiClient, applications []models.Application) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, app := range applications {
+		if app.Name == "" || strings.Contains(app.Name, "*") {
+			continue
+		}
+		privileges := make([]string, 0)
+		for _, privilege := range app.Privileges {
+			if privilege != "" && !strings.ContainsAny(privilege, "/*:") {
+				privileges = append(privileges, privilege)
+			}
+		}
+		if len(privileges) == 0 {
+			continue
+		}
+
+		existing, getDiags := elasticsearch.GetApplicationPrivileges(ctx, client, app.Name)
+		if getDiags.HasError() {
+			tflog.Warn(ctx, fmt.Sprintf(`Unable to check the privileges of the application "%s": %v`, app.Name, getDiags))
+			continue
+		}
+		for _, privilege := range privileges {
+			if _, ok := existing[privilege]; !ok {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Unknown application privilege",
+					Detail:   fmt.Sprintf(`The privilege "%s" of the application "%s" does not exist in the cluster. Declare it with the elasticstack_elasticsearch_security_privilege resource and reference it from the role.`, privilege, app.Name),
+				})
+			}
+		}
+	}
+	return diags
+}
+
+func expandIndexPerms(index map[string]interface{}) models.IndexPerms {
+	definedNames := index["names"].(*schema.Set)
+	names := make([]string, definedNames.Len())
+	for i, name := range definedNames.List() {
+		names[i] = name.(string)
+	}
+	definedPrivs := index["privileges"].(*schema.Set)
+	privs := make([]string, definedPrivs.Len())
+	for i, pr := range definedPrivs.List() {
+		privs[i] = pr.(string)
+	}
+
+	newIndex := models.IndexPerms{
+		Names:      names,
+		Privileges: privs,
+	}
+
+	if query := index["query"].(string); query != "" {
+		newIndex.Query = &query
+	}
+	if fieldSec := index["field_security"].([]interface{}); len(fieldSec) > 0 {
+		fieldSecurity := models.FieldSecurity{}
+		// there must be only 1 entry
+		definedFieldSec := fieldSec[0].(map[string]interface{})
+
+		// grants
+		if gr := definedFieldSec["grant"].(*schema.Set); gr != nil {
+			grants := make([]string, gr.Len())
+			for i, grant := range gr.List() {
+				grants[i] = grant.(string)
+			}
+			fieldSecurity.Grant = grants
+		}
+		// except
+		if exp := definedFieldSec["except"].(*schema.Set); exp != nil {
+			excepts := make([]string, exp.Len())
+			for i, except := range exp.List() {
+				excepts[i] = except.(string)
+			}
+			fieldSecurity.Except = excepts
+		}
+		newIndex.FieldSecurity = &fieldSecurity
+	}
+	return newIndex
 }
 
 func resourceSecurityRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -363,6 +604,12 @@ func resourceSecurityRoleRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	remoteIndexes := role.RemoteIndices
+	remoteIndices := flattenRemoteIndicesData(&remoteIndexes)
+	if err := d.Set("remote_indices", remoteIndices); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if role.Metadata != nil {
 		metadata, err := json.Marshal(role.Metadata)
 		if err != nil {
@@ -377,6 +624,20 @@ func resourceSecurityRoleRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("description", role.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
+	restriction := make([]interface{}, 0)
+	if role.Restriction != nil {
+		restriction = append(restriction, map[string]interface{}{
+			"workflows": role.Restriction.Workflows,
+		})
+	}
+	if err := d.Set("restriction", restriction); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
@@ -435,3 +696,27 @@ func resourceSecurityRoleDelete(ctx context.Context, d *schema.ResourceData, met
 
 	return diags
 }
+
+func flattenRemoteIndicesData(indices *[]models.RemoteIndexPerms) []interface{} {
+	if indices != nil {
+		oindx := make([]interface{}, len(*indices))
+
+		for i, index := range *indices {
+			oi := make(map[string]interface{})
+			oi["clusters"] = index.Clusters
+			oi["names"] = index.Names
+			oi["privileges"] = index.Privileges
+			oi["query"] = index.Query
+
+			if index.FieldSecurity != nil {
+				fsec := make(map[string]interface{})
+				fsec["grant"] = index.FieldSecurity.Grant
+				fsec["except"] = index.FieldSecurity.Except
+				oi["field_security"] = []interface{}{fsec}
+			}
+			oindx[i] = oi
+		}
+		return oindx
+	}
+	return make([]interface{}, 0)
+}
@@ -35,8 +35,9 @@ func ResourceRoleMapping() *schema.Resource {
 		"rules": {
 			Type:             schema.TypeString,
 			Required:         true,
+			ValidateFunc:     validateRoleMappingRulesJSON,
 			DiffSuppressFunc: utils.DiffJsonSuppress,
-			Description:      "The rules that determine which users should be matched by the mapping. A rule is a logical condition that is expressed by using a JSON DSL.",
+			Description:      "The rules that determine which users should be matched by the mapping. A rule is a logical condition that is expressed by using a JSON DSL. Use the `elasticstack_elasticsearch_security_role_mapping_rule` data source to build the rules.",
 		},
 		"roles": {
 			Type: schema.TypeSet,
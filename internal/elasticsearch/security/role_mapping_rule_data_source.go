@@ -0,0 +1,233 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The user fields, which can be matched by the role mapping rules
+var roleMappingRuleFields = []string{"username", "dn", "groups", "realm.name"}
+
+var roleMappingRuleMetadataField = regexp.MustCompile(`^metadata\..+`)
+
+func DataSourceRoleMappingRule() *schema.Resource {
+	ruleKeys := []string{"field", "any", "all", "except"}
+	ruleSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"field": {
+			Description:  "Matches the user field against the values.",
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: ruleKeys,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description:  "The user field to match: `username`, `dn`, `groups`, `realm.name` or `metadata.<key>`.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateRoleMappingRuleField,
+					},
+					"values": {
+						Description: "The values to match the field against. The values support the wildcards and the regular expressions enclosed in `/`. The rule matches if any of the values matches.",
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    1,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+		"any": {
+			Description:  "A list of rules, the rule matches if any of the rules matches. Use the `json` of other `elasticstack_elasticsearch_security_role_mapping_rule` data sources.",
+			Type:         schema.TypeList,
+			Optional:     true,
+			MinItems:     1,
+			ExactlyOneOf: ruleKeys,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateFunc:     validateRoleMappingRulesJSON,
+				DiffSuppressFunc: utils.DiffJsonSuppress,
+			},
+		},
+		"all": {
+			Description:  "A list of rules, the rule matches if all of the rules match. Use the `json` of other `elasticstack_elasticsearch_security_role_mapping_rule` data sources.",
+			Type:         schema.TypeList,
+			Optional:     true,
+			MinItems:     1,
+			ExactlyOneOf: ruleKeys,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateFunc:     validateRoleMappingRulesJSON,
+				DiffSuppressFunc: utils.DiffJsonSuppress,
+			},
+		},
+		"except": {
+			Description:      "A rule, which must not match. It can be only used within the `all` rule.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ExactlyOneOf:     ruleKeys,
+			ValidateFunc:     validateRoleMappingRulesJSON,
+			DiffSuppressFunc: utils.DiffJsonSuppress,
+		},
+		"json": {
+			Description: "JSON representation of this data source.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Builds a role mapping rule, which can be used in the `rules` of the role mapping or nested in other rules. See: https://www.elastic.co/guide/en/elasticsearch/reference/current/role-mapping-resources.html#mapping-roles-rule-field",
+
+		ReadContext: dataSourceSecurityRoleMappingRuleRead,
+
+		Schema: ruleSchema,
+	}
+}
+
+func dataSourceSecurityRoleMappingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rule := &models.RoleMappingRule{}
+
+	if v, ok := d.GetOk("field"); ok {
+		field := v.([]interface{})[0].(map[string]interface{})
+		values := field["values"].([]interface{})
+		var value interface{} = values
+		if len(values) == 1 {
+			value = values[0]
+		}
+		rule.Field = map[string]interface{}{field["name"].(string): value}
+	}
+	if v, ok := d.GetOk("any"); ok {
+		rules, err := expandRoleMappingRules(v.([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rule.Any = rules
+	}
+	if v, ok := d.GetOk("all"); ok {
+		rules, err := expandRoleMappingRules(v.([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rule.All = rules
+	}
+	if v, ok := d.GetOk("except"); ok {
+		except := make(map[string]interface{})
+		if err := json.NewDecoder(strings.NewReader(v.(string))).Decode(&except); err != nil {
+			return diag.FromErr(err)
+		}
+		rule.Except = except
+	}
+
+	ruleJson, err := json.MarshalIndent(rule, "", " ")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("json", string(ruleJson)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	hash, err := utils.StringToHash(string(ruleJson))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*hash)
+
+	return diags
+}
+
+func expandRoleMappingRules(definedRules []interface{}) ([]map[string]interface{}, error) {
+	rules := make([]map[string]interface{}, len(definedRules))
+	for i, r := range definedRules {
+		rule := make(map[string]interface{})
+		if err := json.NewDecoder(strings.NewReader(r.(string))).Decode(&rule); err != nil {
+			return nil, err
+		}
+		rules[i] = rule
+	}
+	return rules, nil
+}
+
+func validateRoleMappingRuleField(i interface{}, k string) ([]string, []error) {
+	name, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	for _, field := range roleMappingRuleFields {
+		if name == field {
+			return nil, nil
+		}
+	}
+	if roleMappingRuleMetadataField.MatchString(name) {
+		return nil, nil
+	}
+	return nil, []error{fmt.Errorf("expected %s to be one of %q or `metadata.<key>`, got %s", k, roleMappingRuleFields, name)}
+}
+
+// Validates the JSON of the role mapping rules, including the names of the matched fields
+func validateRoleMappingRulesJSON(i interface{}, k string) ([]string, []error) {
+	warnings, errors := validation.StringIsJSON(i, k)
+	if len(errors) > 0 {
+		return warnings, errors
+	}
+
+	var rule interface{}
+	if err := json.Unmarshal([]byte(i.(string)), &rule); err != nil {
+		return warnings, []error{fmt.Errorf("%q contains an invalid JSON: %s", k, err)}
+	}
+	return warnings, validateRoleMappingRule(rule, k)
+}
+
+func validateRoleMappingRule(r interface{}, path string) []error {
+	rule, ok := r.(map[string]interface{})
+	if !ok || len(rule) != 1 {
+		return []error{fmt.Errorf("%s: the rule must be an object with exactly one of `field`, `any`, `all` or `except` keys", path)}
+	}
+
+	var errors []error
+	for key, value := range rule {
+		switch key {
+		case "field":
+			field, ok := value.(map[string]interface{})
+			if !ok || len(field) != 1 {
+				return []error{fmt.Errorf("%s.field: the field rule must be an object with exactly one field", path)}
+			}
+			for name := range field {
+				_, errs := validateRoleMappingRuleField(name, fmt.Sprintf("%s.field", path))
+				errors = append(errors, errs...)
+			}
+		case "any", "all":
+			rules, ok := value.([]interface{})
+			if !ok {
+				return []error{fmt.Errorf("%s.%s: the rule must be a list of rules", path, key)}
+			}
+			for i, r := range rules {
+				errors = append(errors, validateRoleMappingRule(r, fmt.Sprintf("%s.%s.%d", path, key, i))...)
+			}
+		case "except":
+			errors = append(errors, validateRoleMappingRule(value, fmt.Sprintf("%s.except", path))...)
+		default:
+			errors = append(errors, fmt.Errorf("%s: unknown rule %q, expected one of `field`, `any`, `all` or `except`", path, key))
+		}
+	}
+	return errors
+}
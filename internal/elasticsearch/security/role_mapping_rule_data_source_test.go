@@ -0,0 +1,136 @@
+package security_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityRoleMappingRule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecurityRoleMappingRule,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("data.elasticstack_elasticsearch_security_role_mapping_rule.test", "json", checkJson(expectedJsonRoleMappingRule)),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role_mapping.test", "rules", `{"all":[{"any":[{"field":{"groups":["cn=admins,dc=example,dc=com","cn=ops,dc=example,dc=com"]}},{"field":{"username":"esadmin"}}]},{"except":{"field":{"realm.name":"file"}}}]}`),
+				),
+			},
+			{
+				Config:      testAccDataSourceSecurityRoleMappingRuleInvalidField,
+				ExpectError: regexp.MustCompile(`to be one of`),
+			},
+			{
+				Config:      testAccResourceSecurityRoleMappingInvalidRules,
+				ExpectError: regexp.MustCompile(`rules.any.0.field`),
+			},
+		},
+	})
+}
+
+func checkJson(expected string) resource.CheckResourceAttrWithFunc {
+	return func(value string) error {
+		if eq, err := utils.JSONBytesEqual([]byte(expected), []byte(value)); !eq {
+			return fmt.Errorf("expected %#v, got %#v (<err>: %v)", expected, value, err)
+		}
+		return nil
+	}
+}
+
+const expectedJsonRoleMappingRule = `{
+  "all": [
+    {
+      "any": [
+        { "field": { "groups": ["cn=admins,dc=example,dc=com", "cn=ops,dc=example,dc=com"] } },
+        { "field": { "username": "esadmin" } }
+      ]
+    },
+    { "except": { "field": { "realm.name": "file" } } }
+  ]
+}`
+
+const testAccDataSourceSecurityRoleMappingRule = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_rule" "groups" {
+  field {
+    name   = "groups"
+    values = ["cn=admins,dc=example,dc=com", "cn=ops,dc=example,dc=com"]
+  }
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_rule" "username" {
+  field {
+    name   = "username"
+    values = ["esadmin"]
+  }
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_rule" "admins" {
+  any = [
+    data.elasticstack_elasticsearch_security_role_mapping_rule.groups.json,
+    data.elasticstack_elasticsearch_security_role_mapping_rule.username.json,
+  ]
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_rule" "file_realm" {
+  field {
+    name   = "realm.name"
+    values = ["file"]
+  }
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_rule" "not_file_realm" {
+  except = data.elasticstack_elasticsearch_security_role_mapping_rule.file_realm.json
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_rule" "test" {
+  all = [
+    data.elasticstack_elasticsearch_security_role_mapping_rule.admins.json,
+    data.elasticstack_elasticsearch_security_role_mapping_rule.not_file_realm.json,
+  ]
+}
+
+resource "elasticstack_elasticsearch_security_role_mapping" "test" {
+  name  = "rule_data_source_test"
+  roles = ["admin"]
+  rules = data.elasticstack_elasticsearch_security_role_mapping_rule.test.json
+}
+`
+
+const testAccDataSourceSecurityRoleMappingRuleInvalidField = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_role_mapping_rule" "test" {
+  field {
+    name   = "group"
+    values = ["cn=admins,dc=example,dc=com"]
+  }
+}
+`
+
+const testAccResourceSecurityRoleMappingInvalidRules = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role_mapping" "test" {
+  name  = "rule_data_source_test"
+  roles = ["admin"]
+  rules = jsonencode({
+    any = [
+      { field = { user = "esadmin" } },
+    ]
+  })
+}
+`
@@ -2,10 +2,13 @@ package security_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/security"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -49,6 +52,75 @@ func TestAccResourceSecurityRole(t *testing.T) {
 	})
 }
 
+func TestAccResourceSecurityRoleRemoteIndices(t *testing.T) {
+	// generate a random role name
+	roleName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityRoleDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.RoleRemoteIndicesMinVersion),
+				Config:   testAccResourceSecurityRoleRemoteIndices(roleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role.test", "name", roleName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role.test", "remote_indices.#", "1"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_role.test", "remote_indices.*.clusters.*", "remote-*"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_role.test", "remote_indices.*.names.*", "logs-*"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_elasticsearch_security_role.test", "remote_indices.*.privileges.*", "read"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecurityRoleDescription(t *testing.T) {
+	// generate a random role name
+	roleName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityRoleDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.RoleDescriptionMinVersion),
+				Config:   testAccResourceSecurityRoleDescription(roleName, "Monitoring access"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role.test", "name", roleName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role.test", "description", "Monitoring access"),
+				),
+			},
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.RoleDescriptionMinVersion),
+				Config:   testAccResourceSecurityRoleDescription(roleName, "Read-only monitoring access"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_role.test", "description", "Read-only monitoring access"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecurityRoleInvalidPrivileges(t *testing.T) {
+	// generate a random role name
+	roleName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityRoleDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceSecurityRoleInvalidPrivileges(roleName),
+				ExpectError: regexp.MustCompile(`unknown privileges: cluster privilege "monitr", index privilege "raed"`),
+			},
+		},
+	})
+}
+
 func testAccResourceSecurityRoleCreate(roleName string) string {
 	return fmt.Sprintf(`
 provider "elasticstack" {
@@ -102,6 +174,28 @@ resource "elasticstack_elasticsearch_security_role" "test" {
 	`, roleName)
 }
 
+func testAccResourceSecurityRoleRemoteIndices(roleName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role" "test" {
+  name    = "%s"
+  cluster = ["monitor"]
+
+  remote_indices {
+    clusters   = ["remote-*"]
+    names      = ["logs-*"]
+    privileges = ["read", "view_index_metadata"]
+    field_security {
+      grant = ["*"]
+    }
+  }
+}
+	`, roleName)
+}
+
 func checkResourceSecurityRoleDestroy(s *terraform.State) error {
 	client, err := clients.NewAcceptanceTestingClient()
 	if err != nil {
@@ -126,3 +220,35 @@ func checkResourceSecurityRoleDestroy(s *terraform.State) error {
 	}
 	return nil
 }
+
+func testAccResourceSecurityRoleDescription(roleName, description string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role" "test" {
+  name        = "%s"
+  description = "%s"
+  cluster     = ["monitor"]
+}
+	`, roleName, description)
+}
+
+func testAccResourceSecurityRoleInvalidPrivileges(roleName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_role" "test" {
+  name    = "%s"
+  cluster = ["monitr", "cluster:monitor/*"]
+
+  indices {
+    names      = ["index1"]
+    privileges = ["raed", "indices:data/read/*"]
+  }
+}
+	`, roleName)
+}
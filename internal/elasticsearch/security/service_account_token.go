@@ -0,0 +1,150 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var ServiceAccountMinVersion = version.Must(version.NewVersion("7.13.0")) // Enabled in 7.13
+
+func ResourceServiceAccountToken() *schema.Resource {
+	tokenSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"namespace": {
+			Description: "The namespace of the service account, e.g. `elastic`.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"service": {
+			Description: "The name of the service, e.g. `fleet-server`.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the token. It must be unique within the service account.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 256),
+				validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-][a-zA-Z0-9_-]*$`), "must contain alphanumeric characters (a-z, A-Z, 0-9), dashes (-) and underscores (_), and must not begin with an underscore"),
+			),
+		},
+		"value": {
+			Description: "The bearer token value, which is only returned when the token is created.",
+			Type:        schema.TypeString,
+			Sensitive:   true,
+			Computed:    true,
+		},
+	}
+
+	utils.AddConnectionSchema(tokenSchema)
+
+	return &schema.Resource{
+		Description: "Creates a service account token for access without requiring basic authentication. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-create-service-token.html",
+
+		CreateContext: resourceSecurityServiceAccountTokenCreate,
+		UpdateContext: resourceSecurityServiceAccountTokenUpdate,
+		ReadContext:   resourceSecurityServiceAccountTokenRead,
+		DeleteContext: resourceSecurityServiceAccountTokenDelete,
+
+		Schema: tokenSchema,
+	}
+}
+
+func resourceSecurityServiceAccountTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	serverVersion, diags := client.ServerVersion(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	if serverVersion.LessThan(ServiceAccountMinVersion) {
+		return diag.Errorf("Service account tokens are supported only for Elasticsearch v%s and above", ServiceAccountMinVersion.String())
+	}
+
+	namespace := d.Get("namespace").(string)
+	service := d.Get("service").(string)
+	name := d.Get("name").(string)
+
+	// the resource identifier contains slashes, so the fields are never parsed back from the ID
+	id, diags := client.ID(ctx, fmt.Sprintf("%s/%s/%s", namespace, service, name))
+	if diags.HasError() {
+		return diags
+	}
+
+	token, diags := elasticsearch.CreateServiceAccountToken(ctx, client, namespace, service, name)
+	if diags.HasError() {
+		return diags
+	}
+	if err := d.Set("value", token.Value); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return resourceSecurityServiceAccountTokenRead(ctx, d, meta)
+}
+
+// all the token fields force a new resource, only the connection settings can be changed in place
+func resourceSecurityServiceAccountTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceSecurityServiceAccountTokenRead(ctx, d, meta)
+}
+
+func resourceSecurityServiceAccountTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	namespace := d.Get("namespace").(string)
+	service := d.Get("service").(string)
+	name := d.Get("name").(string)
+
+	credentials, diags := elasticsearch.GetServiceAccountCredentials(ctx, client, namespace, service)
+	if diags.HasError() {
+		return diags
+	}
+	if credentials == nil {
+		tflog.Warn(ctx, fmt.Sprintf(`Service account "%s/%s" not found, removing from state`, namespace, service))
+		d.SetId("")
+		return diags
+	}
+	if _, ok := credentials.Tokens[name]; !ok {
+		tflog.Warn(ctx, fmt.Sprintf(`Service account token "%s/%s/%s" not found, removing from state`, namespace, service, name))
+		d.SetId("")
+		return diags
+	}
+
+	return diags
+}
+
+func resourceSecurityServiceAccountTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	if diags := elasticsearch.DeleteServiceAccountToken(ctx, client, d.Get("namespace").(string), d.Get("service").(string), d.Get("name").(string)); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return diags
+}
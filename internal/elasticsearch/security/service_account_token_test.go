@@ -0,0 +1,81 @@
+package security_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/security"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceSecurityServiceAccountToken(t *testing.T) {
+	// generate a random name
+	tokenName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityServiceAccountTokenDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.ServiceAccountMinVersion),
+				Config:   testAccResourceSecurityServiceAccountTokenCreate(tokenName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_service_account_token.test", "namespace", "elastic"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_service_account_token.test", "service", "fleet-server"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_service_account_token.test", "name", tokenName),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_security_service_account_token.test", "value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityServiceAccountTokenCreate(tokenName string) string {
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_service_account_token" "test" {
+  namespace = "elastic"
+  service   = "fleet-server"
+  name      = "%s"
+}
+	`, tokenName)
+}
+
+func checkResourceSecurityServiceAccountTokenDestroy(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_elasticsearch_security_service_account_token" {
+			continue
+		}
+		namespace := rs.Primary.Attributes["namespace"]
+		service := rs.Primary.Attributes["service"]
+		name := rs.Primary.Attributes["name"]
+
+		credentials, diags := elasticsearch.GetServiceAccountCredentials(context.Background(), client, namespace, service)
+		if diags.HasError() {
+			return fmt.Errorf("Unable to get service account credentials %v", diags)
+		}
+		if credentials == nil {
+			continue
+		}
+		if _, ok := credentials.Tokens[name]; ok {
+			return fmt.Errorf("Service account token (%s/%s/%s) still exists", namespace, service, name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceServiceAccounts() *schema.Resource {
+	serviceAccountsSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "Internal identifier of the resource",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"namespace": {
+			Description: "Only list the service accounts of this namespace.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"service": {
+			Description:  "Only list the service account of this service. Requires `namespace` to be set.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"namespace"},
+		},
+		"service_accounts": {
+			Description: "The list of the service accounts.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The full name of the service account, in the `<namespace>/<service>` format.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"namespace": {
+						Description: "The namespace of the service account.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"service": {
+						Description: "The name of the service.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"role_descriptor": {
+						Description: "The role descriptor of the service account, as JSON.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+
+	utils.AddConnectionSchema(serviceAccountsSchema)
+
+	return &schema.Resource{
+		Description: "Retrieves the service accounts. See, https://www.elastic.co/guide/en/elasticsearch/reference/current/security-api-get-service-accounts.html",
+
+		ReadContext: dataSourceSecurityServiceAccountsRead,
+
+		Schema: serviceAccountsSchema,
+	}
+}
+
+func dataSourceSecurityServiceAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, diags := clients.NewApiClient(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	serverVersion, diags := client.ServerVersion(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	if serverVersion.LessThan(ServiceAccountMinVersion) {
+		return diag.Errorf("Service accounts are supported only for Elasticsearch v%s and above", ServiceAccountMinVersion.String())
+	}
+
+	namespace := d.Get("namespace").(string)
+	service := d.Get("service").(string)
+	resourceId := "_all"
+	if namespace != "" {
+		resourceId = namespace
+	}
+	if service != "" {
+		resourceId += ":" + service
+	}
+	id, diags := client.ID(ctx, resourceId)
+	if diags.HasError() {
+		return diags
+	}
+
+	accounts, diags := elasticsearch.GetServiceAccounts(ctx, client, namespace, service)
+	if diags.HasError() {
+		return diags
+	}
+
+	names := make([]string, 0, len(accounts))
+	for name := range accounts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	serviceAccounts := make([]interface{}, len(names))
+	for i, name := range names {
+		roleDescriptor, err := json.Marshal(accounts[name].RoleDescriptor)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		account := map[string]interface{}{
+			"name":            name,
+			"role_descriptor": string(roleDescriptor),
+		}
+		if parts := strings.SplitN(name, "/", 2); len(parts) == 2 {
+			account["namespace"] = parts[0]
+			account["service"] = parts[1]
+		}
+		serviceAccounts[i] = account
+	}
+	if err := d.Set("service_accounts", serviceAccounts); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	return diags
+}
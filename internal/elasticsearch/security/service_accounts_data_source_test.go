@@ -0,0 +1,41 @@
+package security_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/security"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityServiceAccounts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: versionutils.CheckIfVersionIsUnsupported(security.ServiceAccountMinVersion),
+				Config:   testAccDataSourceSecurityServiceAccounts,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_service_accounts.test", "service_accounts.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_service_accounts.test", "service_accounts.0.name", "elastic/fleet-server"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_service_accounts.test", "service_accounts.0.namespace", "elastic"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_security_service_accounts.test", "service_accounts.0.service", "fleet-server"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_security_service_accounts.test", "service_accounts.0.role_descriptor"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceSecurityServiceAccounts = `
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_security_service_accounts" "test" {
+  namespace = "elastic"
+  service   = "fleet-server"
+}
+`
@@ -40,7 +40,7 @@ func ResourceUser() *schema.Resource {
 			Optional:      true,
 			Sensitive:     true,
 			ValidateFunc:  validation.StringLenBetween(6, 128),
-			ConflictsWith: []string{"password_hash"},
+			ConflictsWith: []string{"password_hash", "password_wo"},
 		},
 		"password_hash": {
 			Description:   "A hash of the user’s password. This must be produced using the same hashing algorithm as has been configured for password storage (see https://www.elastic.co/guide/en/elasticsearch/reference/current/security-settings.html#hashing-settings).",
@@ -48,7 +48,32 @@ func ResourceUser() *schema.Resource {
 			Optional:      true,
 			Sensitive:     true,
 			ValidateFunc:  validation.StringLenBetween(6, 128),
-			ConflictsWith: []string{"password"},
+			ConflictsWith: []string{"password", "password_wo"},
+		},
+		"password_wo": {
+			Description:   "The user’s password, which is never stored in the state. The password is sent to Elasticsearch when the user is created and every time `password_version` changes. Passwords must be at least 6 characters long.",
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ValidateFunc:  validation.StringLenBetween(6, 128),
+			ConflictsWith: []string{"password", "password_hash"},
+			// the value is read from the configuration only when it must be sent, so it never gets into the state
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return true
+			},
+		},
+		"password_version": {
+			Description:  "An arbitrary version of the `password_wo`, change it to update the password of the user.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"password_wo"},
+		},
+		"password_hashing_algorithm": {
+			Description:  "Hash the `password_wo` locally with this algorithm and send only the hash to Elasticsearch. It must match the `xpack.security.authc.password_hashing.algorithm` setting of the cluster (see https://www.elastic.co/guide/en/elasticsearch/reference/current/security-settings.html#password-hashing-algorithms). The `pbkdf2_stretch` algorithms pre-hash the password with SHA-512 before the key derivation, as Elasticsearch does.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"password_wo"},
+			ValidateFunc: validation.StringInSlice(utils.PasswordHashingAlgorithms, false),
 		},
 		"full_name": {
 			Description: "The full name of the user.",
@@ -127,6 +152,11 @@ func resourceSecurityUserPut(ctx context.Context, d *schema.ResourceData, meta i
 		pass_hash := v.(string)
 		user.PasswordHash = &pass_hash
 	}
+	if d.IsNewResource() || d.HasChange("password_version") {
+		if diags := expandUserPasswordWo(ctx, client, d, &user); diags.HasError() {
+			return diags
+		}
+	}
 
 	if v, ok := d.GetOk("email"); ok {
 		user.Email = v.(string)
@@ -160,6 +190,36 @@ func resourceSecurityUserPut(ctx context.Context, d *schema.ResourceData, meta i
 	return resourceSecurityUserRead(ctx, d, meta)
 }
 
+// Sets the write-only password, or its local hash, from the configuration
+func expandUserPasswordWo(ctx context.Context, client *clients.ApiClient, d *schema.ResourceData, user *models.User) diag.Diagnostics {
+	var diags diag.Diagnostics
+	passwordWo := d.GetRawConfig().GetAttr("password_wo")
+	if passwordWo.IsNull() || !passwordWo.IsKnown() {
+		return diags
+	}
+	password := passwordWo.AsString()
+
+	algorithm, ok := d.GetOk("password_hashing_algorithm")
+	if !ok {
+		user.Password = &password
+		return diags
+	}
+
+	clusterAlgorithm, diags := elasticsearch.GetPasswordHashingAlgorithm(ctx, client)
+	if diags.HasError() {
+		return diags
+	}
+	if !strings.EqualFold(algorithm.(string), clusterAlgorithm) {
+		return diag.Errorf(`The password hashing algorithm "%s" does not match the "%s" algorithm configured in the cluster`, algorithm.(string), clusterAlgorithm)
+	}
+	hash, err := utils.HashPassword(password, algorithm.(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	user.PasswordHash = &hash
+	return diags
+}
+
 func resourceSecurityUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, diags := clients.NewApiClient(d, meta)
 	if diags.HasError() {
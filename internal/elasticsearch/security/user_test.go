@@ -153,6 +153,44 @@ func TestAccImportedUserDoesNotResetPassword(t *testing.T) {
 	})
 }
 
+func TestAccResourceSecurityUserPasswordWriteOnly(t *testing.T) {
+	username := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+	initialPassword := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+	updatedPassword := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             checkResourceSecurityUserDestroy,
+		ProtoV5ProviderFactories: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityUserPasswordWriteOnly(username, initialPassword, "1", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_user.test", "password_wo", ""),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_user.test", "password_version", "1"),
+					checkUserCanAuthenticate(username, initialPassword),
+				),
+			},
+			{
+				// the password is not sent without the version change
+				Config: testAccResourceSecurityUserPasswordWriteOnly(username, updatedPassword, "1", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_user.test", "password_wo", ""),
+					checkUserCanAuthenticate(username, initialPassword),
+				),
+			},
+			{
+				Config: testAccResourceSecurityUserPasswordWriteOnly(username, updatedPassword, "2", "bcrypt"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_user.test", "password_wo", ""),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_security_user.test", "password_version", "2"),
+					checkUserCanAuthenticate(username, updatedPassword),
+				),
+			},
+		},
+	})
+}
+
 func checkUserCanAuthenticate(username string, password string) func(*terraform.State) error {
 	return func(s *terraform.State) error {
 		client, err := clients.NewAcceptanceTestingClient()
@@ -225,6 +263,26 @@ resource "elasticstack_elasticsearch_security_user" "test" {
 	`, username, role)
 }
 
+func testAccResourceSecurityUserPasswordWriteOnly(username, password, version, algorithm string) string {
+	hashing := ""
+	if algorithm != "" {
+		hashing = fmt.Sprintf(`password_hashing_algorithm = "%s"`, algorithm)
+	}
+	return fmt.Sprintf(`
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_security_user" "test" {
+  username         = "%s"
+  roles            = ["kibana_user"]
+  password_wo      = "%s"
+  password_version = "%s"
+  %s
+}
+	`, username, password, version, hashing)
+}
+
 func checkResourceSecurityUserDestroy(s *terraform.State) error {
 	client, err := clients.NewAcceptanceTestingClient()
 	if err != nil {
@@ -6,6 +6,35 @@ type IngestPipeline struct {
 	OnFailure   []map[string]interface{} `json:"on_failure,omitempty"`
 	Processors  []map[string]interface{} `json:"processors"`
 	Metadata    map[string]interface{}   `json:"_meta,omitempty"`
+	Version     *int                     `json:"version,omitempty"`
+	Deprecated  *bool                    `json:"deprecated,omitempty"`
+}
+
+type IngestPipelineSimulation struct {
+	PipelineID string                   `json:"-"`
+	Pipeline   *IngestPipeline          `json:"pipeline,omitempty"`
+	Docs       []map[string]interface{} `json:"docs"`
+	Verbose    bool                     `json:"-"`
+}
+
+type IngestSimulatedDocument struct {
+	Doc              *IngestDocument                  `json:"doc"`
+	Error            map[string]interface{}           `json:"error"`
+	ProcessorResults []IngestSimulatedProcessorResult `json:"processor_results"`
+}
+
+type IngestSimulatedProcessorResult struct {
+	ProcessorType string                 `json:"processor_type"`
+	Tag           string                 `json:"tag"`
+	Status        string                 `json:"status"`
+	Doc           *IngestDocument        `json:"doc"`
+	Error         map[string]interface{} `json:"error"`
+}
+
+type IngestDocument struct {
+	Index  string                 `json:"_index"`
+	Id     string                 `json:"_id"`
+	Source map[string]interface{} `json:"_source"`
 }
 
 type CommonProcessor struct {
@@ -31,6 +60,17 @@ type ProcessorAppend struct {
 	MediaType       string   `json:"media_type,omitempty"`
 }
 
+type ProcessorAttachment struct {
+	CommonProcessor
+	ProcessortFields
+
+	IndexedChars      *int     `json:"indexed_chars,omitempty"`
+	IndexedCharsField string   `json:"indexed_chars_field,omitempty"`
+	Properties        []string `json:"properties,omitempty"`
+	RemoveBinary      *bool    `json:"remove_binary,omitempty"`
+	ResourceName      string   `json:"resource_name,omitempty"`
+}
+
 type ProcessorBytes struct {
 	CommonProcessor
 	ProcessortFields
@@ -157,6 +197,18 @@ type ProcessorForeach struct {
 	Processor     map[string]interface{} `json:"processor"`
 }
 
+type ProcessorGeoGrid struct {
+	CommonProcessor
+	ProcessortFields
+
+	TileType         string `json:"tile_type"`
+	ParentField      string `json:"parent_field,omitempty"`
+	ChildrenField    string `json:"children_field,omitempty"`
+	NonChildrenField string `json:"non_children_field,omitempty"`
+	PrecisionField   string `json:"precision_field,omitempty"`
+	TargetFormat     string `json:"target_format,omitempty"`
+}
+
 type ProcessorGeoip struct {
 	ProcessortFields
 
@@ -189,6 +241,32 @@ type ProcessorHtmlStrip struct {
 	ProcessortFields
 }
 
+type ProcessorInference struct {
+	CommonProcessor
+
+	ModelId         string                 `json:"model_id"`
+	TargetField     string                 `json:"target_field,omitempty"`
+	FieldMap        map[string]interface{} `json:"field_map,omitempty"`
+	InputOutput     []InferenceInputOutput `json:"input_output,omitempty"`
+	InferenceConfig map[string]interface{} `json:"inference_config,omitempty"`
+	IgnoreMissing   bool                   `json:"ignore_missing,omitempty"`
+}
+
+type InferenceInputOutput struct {
+	InputField  string `json:"input_field"`
+	OutputField string `json:"output_field,omitempty"`
+}
+
+type ProcessorIpLocation struct {
+	CommonProcessor
+	ProcessortFields
+
+	DatabaseFile                       string   `json:"database_file,omitempty"`
+	Properties                         []string `json:"properties,omitempty"`
+	FirstOnly                          bool     `json:"first_only"`
+	DownloadDatabaseOnPipelineCreation *bool    `json:"download_database_on_pipeline_creation,omitempty"`
+}
+
 type ProcessorJoin struct {
 	CommonProcessor
 
@@ -243,6 +321,19 @@ type ProcessorPipeline struct {
 	Name string `json:"name"`
 }
 
+type ProcessorRedact struct {
+	CommonProcessor
+
+	Field              string            `json:"field"`
+	Patterns           []string          `json:"patterns"`
+	PatternDefinitions map[string]string `json:"pattern_definitions,omitempty"`
+	Prefix             string            `json:"prefix,omitempty"`
+	Suffix             string            `json:"suffix,omitempty"`
+	IgnoreMissing      bool              `json:"ignore_missing"`
+	SkipIfUnlicensed   bool              `json:"skip_if_unlicensed,omitempty"`
+	TraceRedact        bool              `json:"trace_redact,omitempty"`
+}
+
 type ProcessorRegisteredDomain struct {
 	CommonProcessor
 	ProcessortFields
@@ -251,7 +342,8 @@ type ProcessorRegisteredDomain struct {
 type ProcessorRemove struct {
 	CommonProcessor
 
-	Field         []string `json:"field"`
+	Field         []string `json:"field,omitempty"`
+	Keep          []string `json:"keep,omitempty"`
 	IgnoreMissing bool     `json:"ignore_missing"`
 }
 
@@ -260,6 +352,14 @@ type ProcessorRename struct {
 	ProcessortFields
 }
 
+type ProcessorReroute struct {
+	CommonProcessor
+
+	Destination string   `json:"destination,omitempty"`
+	Dataset     []string `json:"dataset,omitempty"`
+	Namespace   []string `json:"namespace,omitempty"`
+}
+
 type ProcessorScript struct {
 	CommonProcessor
 
@@ -303,6 +403,10 @@ type ProcessorSplit struct {
 	PreserveTrailing bool   `json:"preserve_trailing"`
 }
 
+type ProcessorTerminate struct {
+	CommonProcessor
+}
+
 type ProcessorTrim struct {
 	CommonProcessor
 	ProcessortFields
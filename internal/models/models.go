@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type User struct {
 	Username     string                 `json:"-"`
 	FullName     string                 `json:"full_name,omitempty"`
@@ -25,13 +27,68 @@ type UserPassword struct {
 }
 
 type Role struct {
-	Name         string                 `json:"-"`
-	Applications []Application          `json:"applications,omitempty"`
-	Global       map[string]interface{} `json:"global,omitempty"`
-	Cluster      []string               `json:"cluster,omitempty"`
-	Indices      []IndexPerms           `json:"indices,omitempty"`
-	Metadata     map[string]interface{} `json:"metadata,omitempty"`
-	RusAs        []string               `json:"run_as,omitempty"`
+	Name          string                 `json:"-"`
+	Description   string                 `json:"description,omitempty"`
+	Applications  []Application          `json:"applications,omitempty"`
+	Global        map[string]interface{} `json:"global,omitempty"`
+	Cluster       []string               `json:"cluster,omitempty"`
+	Indices       []IndexPerms           `json:"indices,omitempty"`
+	RemoteIndices []RemoteIndexPerms     `json:"remote_indices,omitempty"`
+	Metadata      map[string]interface{} `json:"metadata,omitempty"`
+	RusAs         []string               `json:"run_as,omitempty"`
+	Restriction   *RoleRestriction       `json:"restriction,omitempty"`
+}
+
+type RoleRestriction struct {
+	Workflows []string `json:"workflows"`
+}
+
+type BuiltinPrivileges struct {
+	Cluster []string `json:"cluster"`
+	Index   []string `json:"index"`
+}
+
+type AuthenticateResponse struct {
+	Username            string                 `json:"username"`
+	FullName            string                 `json:"full_name"`
+	Email               string                 `json:"email"`
+	Roles               []string               `json:"roles"`
+	Metadata            map[string]interface{} `json:"metadata"`
+	Enabled             bool                   `json:"enabled"`
+	AuthenticationRealm Realm                  `json:"authentication_realm"`
+	LookupRealm         Realm                  `json:"lookup_realm"`
+	AuthenticationType  string                 `json:"authentication_type"`
+	ApiKey              *AuthenticateApiKey    `json:"api_key,omitempty"`
+}
+
+type Realm struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type AuthenticateApiKey struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type HasPrivilegesRequest struct {
+	Cluster     []string             `json:"cluster,omitempty"`
+	Index       []HasPrivilegesIndex `json:"index,omitempty"`
+	Application []Application        `json:"application,omitempty"`
+}
+
+type HasPrivilegesIndex struct {
+	Names                  []string `json:"names"`
+	Privileges             []string `json:"privileges"`
+	AllowRestrictedIndices *bool    `json:"allow_restricted_indices,omitempty"`
+}
+
+type HasPrivilegesResponse struct {
+	Username        string                                `json:"username"`
+	HasAllRequested bool                                  `json:"has_all_requested"`
+	Cluster         map[string]bool                       `json:"cluster"`
+	Index           map[string]map[string]bool            `json:"index"`
+	Application     map[string]map[string]map[string]bool `json:"application"`
 }
 
 type RoleMapping struct {
@@ -43,6 +100,13 @@ type RoleMapping struct {
 	Metadata      interface{}              `json:"metadata"`
 }
 
+type RoleMappingRule struct {
+	Field  map[string]interface{}   `json:"field,omitempty"`
+	Any    []map[string]interface{} `json:"any,omitempty"`
+	All    []map[string]interface{} `json:"all,omitempty"`
+	Except map[string]interface{}   `json:"except,omitempty"`
+}
+
 type ApiKey struct {
 	Name             string                 `json:"name"`
 	RolesDescriptors map[string]Role        `json:"role_descriptors,omitempty"`
@@ -50,14 +114,71 @@ type ApiKey struct {
 	Metadata         map[string]interface{} `json:"metadata,omitempty"`
 }
 
+type ApiKeyUpdate struct {
+	RolesDescriptors map[string]Role        `json:"role_descriptors"`
+	Metadata         map[string]interface{} `json:"metadata"`
+	Expiration       string                 `json:"expiration,omitempty"`
+}
+
+type ApiKeyGrant struct {
+	GrantType   string `json:"grant_type"`
+	Username    string `json:"username,omitempty"`
+	Password    string `json:"password,omitempty"`
+	AccessToken string `json:"access_token,omitempty"`
+	RunAs       string `json:"run_as,omitempty"`
+	ApiKey      ApiKey `json:"api_key"`
+}
+
+type CrossClusterApiKey struct {
+	Name       string                   `json:"name"`
+	Expiration string                   `json:"expiration,omitempty"`
+	Access     CrossClusterApiKeyAccess `json:"access"`
+	Metadata   map[string]interface{}   `json:"metadata,omitempty"`
+}
+
+type CrossClusterApiKeyUpdate struct {
+	Access   CrossClusterApiKeyAccess `json:"access"`
+	Metadata map[string]interface{}   `json:"metadata"`
+}
+
+type CrossClusterApiKeyAccess struct {
+	Search      []CrossClusterApiKeyIndices `json:"search,omitempty"`
+	Replication []CrossClusterApiKeyIndices `json:"replication,omitempty"`
+}
+
+type CrossClusterApiKeyIndices struct {
+	Names                  []string       `json:"names"`
+	FieldSecurity          *FieldSecurity `json:"field_security,omitempty"`
+	Query                  interface{}    `json:"query,omitempty"`
+	AllowRestrictedIndices *bool          `json:"allow_restricted_indices,omitempty"`
+}
+
 type ApiKeyResponse struct {
 	ApiKey
-	RolesDescriptors map[string]Role `json:"role_descriptors,omitempty"`
-	Expiration       int64           `json:"expiration,omitempty"`
-	Id               string          `json:"id,omitempty"`
-	Key              string          `json:"api_key,omitempty"`
-	EncodedKey       string          `json:"encoded,omitempty"`
-	Invalidated      bool            `json:"invalidated,omitempty"`
+	RolesDescriptors map[string]Role           `json:"role_descriptors,omitempty"`
+	Expiration       int64                     `json:"expiration,omitempty"`
+	Creation         int64                     `json:"creation,omitempty"`
+	Type             string                    `json:"type,omitempty"`
+	Access           *CrossClusterApiKeyAccess `json:"access,omitempty"`
+	Id               string                    `json:"id,omitempty"`
+	Key              string                    `json:"api_key,omitempty"`
+	EncodedKey       string                    `json:"encoded,omitempty"`
+	Invalidated      bool                      `json:"invalidated,omitempty"`
+}
+
+type ServiceAccount struct {
+	RoleDescriptor Role `json:"role_descriptor"`
+}
+
+type ServiceAccountToken struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type ServiceAccountCredentials struct {
+	ServiceAccount string                 `json:"service_account"`
+	Count          int                    `json:"count"`
+	Tokens         map[string]interface{} `json:"tokens"`
 }
 
 type IndexPerms struct {
@@ -68,6 +189,11 @@ type IndexPerms struct {
 	AllowRestrictedIndices *bool          `json:"allow_restricted_indices,omitempty"`
 }
 
+type RemoteIndexPerms struct {
+	IndexPerms
+	Clusters []string `json:"clusters"`
+}
+
 type FieldSecurity struct {
 	Grant  []string `json:"grant,omitempty"`
 	Except []string `json:"except,omitempty"`
@@ -79,6 +205,13 @@ type Application struct {
 	Resources  []string `json:"resources"`
 }
 
+type ApplicationPrivilege struct {
+	Application string                 `json:"application,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	Actions     []string               `json:"actions"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
 type IndexTemplate struct {
 	Name          string                 `json:"-"`
 	Create        bool                   `json:"-"`
@@ -150,7 +283,56 @@ type SnapshotRepository struct {
 	Name     string                 `json:"-"`
 	Type     string                 `json:"type"`
 	Settings map[string]interface{} `json:"settings"`
-	Verify   bool                   `json:"verify"`
+	Verify   bool                   `json:"-"`
+}
+
+type SnapshotRepositoryNode struct {
+	Id   string `json:"-"`
+	Name string `json:"name"`
+}
+
+type ClusterNode struct {
+	Id         string            `json:"-"`
+	Name       string            `json:"name"`
+	Host       string            `json:"host"`
+	Ip         string            `json:"ip"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+type CatShard struct {
+	Index  string `json:"index"`
+	Shard  string `json:"shard"`
+	Prirep string `json:"prirep"`
+	State  string `json:"state"`
+	Node   string `json:"node"`
+}
+
+type SnapshotRepositoryAnalysis struct {
+	BlobCount        int
+	MaxBlobSize      string
+	MaxTotalDataSize string
+	Timeout          time.Duration
+}
+
+type SnapshotRepositoryAnalysisResult struct {
+	BlobPath       string                            `json:"blob_path"`
+	Summary        SnapshotRepositoryAnalysisSummary `json:"summary"`
+	ListingElapsed string                            `json:"listing_elapsed"`
+	DeleteElapsed  string                            `json:"delete_elapsed"`
+}
+
+type SnapshotRepositoryAnalysisSummary struct {
+	Write SnapshotRepositoryAnalysisStats `json:"write"`
+	Read  SnapshotRepositoryAnalysisStats `json:"read"`
+}
+
+type SnapshotRepositoryAnalysisStats struct {
+	Count          int    `json:"count"`
+	TotalSize      string `json:"total_size"`
+	TotalThrottled string `json:"total_throttled"`
+	TotalElapsed   string `json:"total_elapsed"`
+	TotalWait      string `json:"total_wait,omitempty"`
+	MaxWait        string `json:"max_wait,omitempty"`
 }
 
 type SnapshotPolicy struct {
@@ -168,6 +350,27 @@ type SnapshortRetention struct {
 	MinCount    *int    `json:"min_count,omitempty"`
 }
 
+type SnapshotPolicyInfo struct {
+	Policy              SnapshotPolicy            `json:"policy"`
+	LastSuccess         *SnapshotPolicyInvocation `json:"last_success,omitempty"`
+	LastFailure         *SnapshotPolicyInvocation `json:"last_failure,omitempty"`
+	NextExecutionMillis int64                     `json:"next_execution_millis"`
+	Stats               *SnapshotPolicyStats      `json:"stats,omitempty"`
+}
+
+type SnapshotPolicyInvocation struct {
+	SnapshotName string `json:"snapshot_name"`
+	Time         int64  `json:"time"`
+	Details      string `json:"details,omitempty"`
+}
+
+type SnapshotPolicyStats struct {
+	SnapshotsTaken           int `json:"snapshots_taken"`
+	SnapshotsFailed          int `json:"snapshots_failed"`
+	SnapshotsDeleted         int `json:"snapshots_deleted"`
+	SnapshotDeletionFailures int `json:"snapshot_deletion_failures"`
+}
+
 type SnapshotPolicyConfig struct {
 	ExpandWildcards    *string                `json:"expand_wildcards,omitempty"`
 	IgnoreUnavailable  *bool                  `json:"ignore_unavailable,omitempty"`
@@ -178,11 +381,84 @@ type SnapshotPolicyConfig struct {
 	Partial            *bool                  `json:"partial,omitempty"`
 }
 
+type Snapshot struct {
+	Name               string                 `json:"-"`
+	Repository         string                 `json:"-"`
+	Indices            []string               `json:"indices,omitempty"`
+	FeatureStates      []string               `json:"feature_states,omitempty"`
+	IncludeGlobalState *bool                  `json:"include_global_state,omitempty"`
+	Partial            *bool                  `json:"partial,omitempty"`
+	Metadata           map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type SnapshotInfo struct {
+	Snapshot           string                 `json:"snapshot"`
+	Uuid               string                 `json:"uuid"`
+	Repository         string                 `json:"repository"`
+	Indices            []string               `json:"indices"`
+	DataStreams        []string               `json:"data_streams"`
+	FeatureStates      []SnapshotFeatureState `json:"feature_states"`
+	IncludeGlobalState bool                   `json:"include_global_state"`
+	Metadata           map[string]interface{} `json:"metadata,omitempty"`
+	State              string                 `json:"state"`
+	Reason             string                 `json:"reason,omitempty"`
+	StartTime          string                 `json:"start_time"`
+	EndTime            string                 `json:"end_time"`
+	DurationInMillis   int64                  `json:"duration_in_millis"`
+	Shards             SnapshotShards         `json:"shards"`
+}
+
+type SnapshotFeatureState struct {
+	FeatureName string   `json:"feature_name"`
+	Indices     []string `json:"indices"`
+}
+
+type SnapshotShards struct {
+	Total      int `json:"total"`
+	Failed     int `json:"failed"`
+	Successful int `json:"successful"`
+}
+
+type SnapshotsQuery struct {
+	Repository string
+	Name       string
+	Sort       string
+	Order      string
+	Size       int
+}
+
+type SnapshotRestore struct {
+	Repository          string                 `json:"-"`
+	Snapshot            string                 `json:"-"`
+	Indices             []string               `json:"indices,omitempty"`
+	IncludeAliases      *bool                  `json:"include_aliases,omitempty"`
+	IncludeGlobalState  *bool                  `json:"include_global_state,omitempty"`
+	Partial             *bool                  `json:"partial,omitempty"`
+	RenamePattern       string                 `json:"rename_pattern,omitempty"`
+	RenameReplacement   string                 `json:"rename_replacement,omitempty"`
+	IndexSettings       map[string]interface{} `json:"index_settings,omitempty"`
+	IgnoreIndexSettings []string               `json:"ignore_index_settings,omitempty"`
+}
+
+type SnapshotRestoreInfo struct {
+	Snapshot string         `json:"snapshot"`
+	Indices  []string       `json:"indices"`
+	Shards   SnapshotShards `json:"shards"`
+}
+
 type Index struct {
-	Name     string                 `json:"-"`
-	Aliases  map[string]IndexAlias  `json:"aliases,omitempty"`
-	Mappings map[string]interface{} `json:"mappings,omitempty"`
-	Settings map[string]interface{} `json:"settings,omitempty"`
+	Name                string                 `json:"-"`
+	WaitForActiveShards string                 `json:"-"`
+	MasterTimeout       time.Duration          `json:"-"`
+	Timeout             time.Duration          `json:"-"`
+	Aliases             map[string]IndexAlias  `json:"aliases,omitempty"`
+	Mappings            map[string]interface{} `json:"mappings,omitempty"`
+	Settings            map[string]interface{} `json:"settings,omitempty"`
+}
+
+type IndexSettings struct {
+	Settings map[string]interface{} `json:"settings"`
+	Defaults map[string]interface{} `json:"defaults"`
 }
 
 type IndexAlias struct {
@@ -195,6 +471,31 @@ type IndexAlias struct {
 	SearchRouting string                 `json:"search_routing,omitempty"`
 }
 
+type IndexAliasAction struct {
+	Add         *IndexAliasActionParams `json:"add,omitempty"`
+	Remove      *IndexAliasActionParams `json:"remove,omitempty"`
+	RemoveIndex *IndexAliasActionParams `json:"remove_index,omitempty"`
+}
+
+type IndexAliasActionParams struct {
+	IndexAlias
+	Index string `json:"index"`
+	Alias string `json:"alias,omitempty"`
+}
+
+type Task struct {
+	Completed bool                   `json:"completed"`
+	Response  *TaskResponse          `json:"response,omitempty"`
+	Error     map[string]interface{} `json:"error,omitempty"`
+}
+
+type TaskResponse struct {
+	Total    int64                    `json:"total"`
+	Created  int64                    `json:"created"`
+	Updated  int64                    `json:"updated"`
+	Failures []map[string]interface{} `json:"failures"`
+}
+
 type DataStream struct {
 	Name           string                 `json:"name"`
 	TimestampField TimestampField         `json:"timestamp_field"`
@@ -235,3 +536,9 @@ type Script struct {
 	Params   map[string]interface{} `json:"params"`
 	Context  string                 `json:"-"`
 }
+
+type SearchTemplateRender struct {
+	ID     string                 `json:"id,omitempty"`
+	Source string                 `json:"source,omitempty"`
+	Params map[string]interface{} `json:"params,omitempty"`
+}
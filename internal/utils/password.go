@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	bcryptDefaultCost       = 10
+	pbkdf2DefaultIterations = 10000
+	pbkdf2SaltLength        = 32
+	pbkdf2KeyLength         = 32
+	pbkdf2Prefix            = "{PBKDF2}"
+	pbkdf2StretchPrefix     = "{PBKDF2_STRETCH}"
+)
+
+var (
+	bcryptAlgorithm = regexp.MustCompile(`^bcrypt(\d+)?$`)
+	pbkdf2Algorithm = regexp.MustCompile(`^pbkdf2(_stretch)?(_\d+)?$`)
+)
+
+// The password hashing algorithms supported by Elasticsearch, which can be computed locally, see https://www.elastic.co/guide/en/elasticsearch/reference/current/security-settings.html#password-hashing-algorithms
+var PasswordHashingAlgorithms = []string{
+	"bcrypt", "bcrypt4", "bcrypt5", "bcrypt6", "bcrypt7", "bcrypt8", "bcrypt9", "bcrypt10", "bcrypt11", "bcrypt12", "bcrypt13", "bcrypt14",
+	"pbkdf2", "pbkdf2_1000", "pbkdf2_10000", "pbkdf2_50000", "pbkdf2_100000", "pbkdf2_500000", "pbkdf2_1000000",
+	"pbkdf2_stretch", "pbkdf2_stretch_1000", "pbkdf2_stretch_10000", "pbkdf2_stretch_50000", "pbkdf2_stretch_100000", "pbkdf2_stretch_500000", "pbkdf2_stretch_1000000",
+}
+
+// HashPassword hashes the password in the format expected by Elasticsearch for the given password hashing algorithm
+func HashPassword(password, algorithm string) (string, error) {
+	if m := bcryptAlgorithm.FindStringSubmatch(algorithm); m != nil {
+		cost := bcryptDefaultCost
+		if m[1] != "" {
+			cost, _ = strconv.Atoi(m[1])
+		}
+		if cost < 4 || cost > 14 {
+			return "", fmt.Errorf("unsupported bcrypt cost %d, expected a value between 4 and 14", cost)
+		}
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), cost)
+		if err != nil {
+			return "", err
+		}
+		return string(hash), nil
+	}
+
+	if m := pbkdf2Algorithm.FindStringSubmatch(algorithm); m != nil {
+		iterations := pbkdf2DefaultIterations
+		if m[2] != "" {
+			iterations, _ = strconv.Atoi(m[2][1:])
+		}
+		if iterations <= 0 {
+			return "", fmt.Errorf("unsupported number of pbkdf2 iterations %d", iterations)
+		}
+		salt := make([]byte, pbkdf2SaltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return "", err
+		}
+		prefix := pbkdf2Prefix
+		if m[1] != "" {
+			// the stretched variant derives the key from the hex encoded SHA-512 digest of the password
+			digest := sha512.Sum512([]byte(password))
+			password = hex.EncodeToString(digest[:])
+			prefix = pbkdf2StretchPrefix
+		}
+		key := pbkdf2.Key([]byte(password), salt, iterations, pbkdf2KeyLength, sha512.New)
+		return fmt.Sprintf("%s%d$%s$%s", prefix, iterations, base64.StdEncoding.EncodeToString(salt), base64.StdEncoding.EncodeToString(key)), nil
+	}
+
+	return "", fmt.Errorf("unsupported password hashing algorithm %q", algorithm)
+}
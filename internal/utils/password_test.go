@@ -0,0 +1,85 @@
+package utils_test
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+func TestHashPassword(t *testing.T) {
+	t.Parallel()
+
+	password := "changeme"
+
+	tests := []struct {
+		algorithm string
+		verify    func(hash string) bool
+	}{
+		{"bcrypt", verifyBcrypt(password, 10)},
+		{"bcrypt4", verifyBcrypt(password, 4)},
+		{"pbkdf2", verifyPbkdf2(password, 10000)},
+		{"pbkdf2_1000", verifyPbkdf2(password, 1000)},
+		{"pbkdf2_stretch", verifyPbkdf2Stretch(password, 10000)},
+		{"pbkdf2_stretch_1000", verifyPbkdf2Stretch(password, 1000)},
+	}
+
+	for _, tc := range tests {
+		hash, err := utils.HashPassword(password, tc.algorithm)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", tc.algorithm, err)
+		}
+		if !tc.verify(hash) {
+			t.Errorf("hash %q does not match the password for %s", hash, tc.algorithm)
+		}
+	}
+
+	for _, algorithm := range []string{"bcrypt3", "bcrypt15", "pbkdf2_stretch_", "sha256", ""} {
+		if _, err := utils.HashPassword(password, algorithm); err == nil {
+			t.Errorf("expected error for %q algorithm", algorithm)
+		}
+	}
+}
+
+func verifyBcrypt(password string, cost int) func(string) bool {
+	return func(hash string) bool {
+		if c, err := bcrypt.Cost([]byte(hash)); err != nil || c != cost {
+			return false
+		}
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+	}
+}
+
+func verifyPbkdf2(password string, iterations int) func(string) bool {
+	return verifyPbkdf2WithPrefix("{PBKDF2}", password, iterations)
+}
+
+func verifyPbkdf2Stretch(password string, iterations int) func(string) bool {
+	digest := sha512.Sum512([]byte(password))
+	return verifyPbkdf2WithPrefix("{PBKDF2_STRETCH}", hex.EncodeToString(digest[:]), iterations)
+}
+
+func verifyPbkdf2WithPrefix(prefix, password string, iterations int) func(string) bool {
+	return func(hash string) bool {
+		parts := strings.Split(strings.TrimPrefix(hash, prefix), "$")
+		if !strings.HasPrefix(hash, prefix) || len(parts) != 3 || parts[0] != strconv.Itoa(iterations) {
+			return false
+		}
+		salt, err := base64.StdEncoding.DecodeString(parts[1])
+		if err != nil {
+			return false
+		}
+		key, err := base64.StdEncoding.DecodeString(parts[2])
+		if err != nil {
+			return false
+		}
+		return bytes.Equal(key, pbkdf2.Key([]byte(password), salt, iterations, len(key), sha512.New))
+	}
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	byteSizeValue = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*(b|kb|mb|gb|tb|pb)$`)
+	timeValue     = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*(nanos|micros|ms|s|m|h|d)$`)
+)
+
+var byteSizeUnits = map[string]float64{
+	"b":  1,
+	"kb": 1 << 10,
+	"mb": 1 << 20,
+	"gb": 1 << 30,
+	"tb": 1 << 40,
+	"pb": 1 << 50,
+}
+
+var timeUnits = map[string]time.Duration{
+	"nanos":  time.Nanosecond,
+	"micros": time.Microsecond,
+	"ms":     time.Millisecond,
+	"s":      time.Second,
+	"m":      time.Minute,
+	"h":      time.Hour,
+	"d":      24 * time.Hour,
+}
+
+// ParseByteSize parses the Elasticsearch byte size value with the unit, e.g. 512mb or 1.5gb, into the number of bytes
+func ParseByteSize(s string) (int64, error) {
+	m := byteSizeValue.FindStringSubmatch(strings.ToLower(strings.TrimSpace(s)))
+	if m == nil {
+		return 0, fmt.Errorf(`"%s" is not a valid byte size value, e.g. 512mb or 1gb`, s)
+	}
+	v, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, err
+	}
+	return int64(math.Round(v * byteSizeUnits[m[2]])), nil
+}
+
+// ParseTimeValue parses the Elasticsearch time value with the unit, e.g. 30s or 1d, into the duration
+func ParseTimeValue(s string) (time.Duration, error) {
+	m := timeValue.FindStringSubmatch(strings.ToLower(strings.TrimSpace(s)))
+	if m == nil {
+		return 0, fmt.Errorf(`"%s" is not a valid time value, e.g. 30s or 1m`, s)
+	}
+	v, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(math.Round(v * float64(timeUnits[m[2]]))), nil
+}
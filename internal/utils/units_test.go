@@ -0,0 +1,82 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils"
+)
+
+func TestParseByteSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in      string
+		out     int64
+		isError bool
+	}{
+		{in: "0b", out: 0},
+		{in: "512b", out: 512},
+		{in: "1kb", out: 1024},
+		{in: "1024mb", out: 1 << 30},
+		{in: "1gb", out: 1 << 30},
+		{in: "1.5GB", out: 3 << 29},
+		{in: "2tb", out: 2 << 40},
+		{in: "10%", isError: true},
+		{in: "1024", isError: true},
+		{in: "1m", isError: true},
+	}
+
+	for _, tc := range tests {
+		res, err := utils.ParseByteSize(tc.in)
+		if tc.isError {
+			if err == nil {
+				t.Errorf("expected error for %s, got %d", tc.in, res)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", tc.in, err)
+		}
+		if res != tc.out {
+			t.Errorf("expected %s to be parsed as %d, got %d", tc.in, tc.out, res)
+		}
+	}
+}
+
+func TestParseTimeValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in      string
+		out     time.Duration
+		isError bool
+	}{
+		{in: "100nanos", out: 100 * time.Nanosecond},
+		{in: "10micros", out: 10 * time.Microsecond},
+		{in: "500ms", out: 500 * time.Millisecond},
+		{in: "60s", out: time.Minute},
+		{in: "1m", out: time.Minute},
+		{in: "1.5h", out: 90 * time.Minute},
+		{in: "1d", out: 24 * time.Hour},
+		{in: "-1", isError: true},
+		{in: "1mb", isError: true},
+		{in: "1w", isError: true},
+	}
+
+	for _, tc := range tests {
+		res, err := utils.ParseTimeValue(tc.in)
+		if tc.isError {
+			if err == nil {
+				t.Errorf("expected error for %s, got %s", tc.in, res)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", tc.in, err)
+		}
+		if res != tc.out {
+			t.Errorf("expected %s to be parsed as %s, got %s", tc.in, tc.out, res)
+		}
+	}
+}
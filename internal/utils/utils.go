@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -162,6 +163,28 @@ func ExpandIndividuallyDefinedSettings(ctx context.Context, d *schema.ResourceDa
 	return settings
 }
 
+// SortedKeys returns the keys of the map in the sorted order
+func SortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func ConvertSettingsKeyToTFFieldKey(settingKey string) string {
 	return strings.Replace(settingKey, ".", "_", -1)
 }
+
+// ValidateDuration checks that the value can be parsed as Go duration, e.g. 30s or 1h30m
+func ValidateDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if _, err := time.ParseDuration(v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a valid duration, e.g. 30s or 1m: %w", k, err)}
+	}
+	return nil, nil
+}
@@ -26,7 +26,9 @@ func New(version string) *schema.Provider {
 			esKeyName: providerSchema.GetConnectionSchema(esKeyName, true),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
+			"elasticstack_elasticsearch_ingest_pipeline_simulate":           ingest.DataSourceIngestPipelineSimulate(),
 			"elasticstack_elasticsearch_ingest_processor_append":            ingest.DataSourceProcessorAppend(),
+			"elasticstack_elasticsearch_ingest_processor_attachment":        ingest.DataSourceProcessorAttachment(),
 			"elasticstack_elasticsearch_ingest_processor_bytes":             ingest.DataSourceProcessorBytes(),
 			"elasticstack_elasticsearch_ingest_processor_circle":            ingest.DataSourceProcessorCircle(),
 			"elasticstack_elasticsearch_ingest_processor_community_id":      ingest.DataSourceProcessorCommunityId(),
@@ -41,51 +43,73 @@ func New(version string) *schema.Provider {
 			"elasticstack_elasticsearch_ingest_processor_fail":              ingest.DataSourceProcessorFail(),
 			"elasticstack_elasticsearch_ingest_processor_fingerprint":       ingest.DataSourceProcessorFingerprint(),
 			"elasticstack_elasticsearch_ingest_processor_foreach":           ingest.DataSourceProcessorForeach(),
+			"elasticstack_elasticsearch_ingest_processor_geo_grid":          ingest.DataSourceProcessorGeoGrid(),
 			"elasticstack_elasticsearch_ingest_processor_geoip":             ingest.DataSourceProcessorGeoip(),
 			"elasticstack_elasticsearch_ingest_processor_grok":              ingest.DataSourceProcessorGrok(),
 			"elasticstack_elasticsearch_ingest_processor_gsub":              ingest.DataSourceProcessorGsub(),
 			"elasticstack_elasticsearch_ingest_processor_html_strip":        ingest.DataSourceProcessorHtmlStrip(),
+			"elasticstack_elasticsearch_ingest_processor_inference":         ingest.DataSourceProcessorInference(),
+			"elasticstack_elasticsearch_ingest_processor_ip_location":       ingest.DataSourceProcessorIpLocation(),
 			"elasticstack_elasticsearch_ingest_processor_join":              ingest.DataSourceProcessorJoin(),
 			"elasticstack_elasticsearch_ingest_processor_json":              ingest.DataSourceProcessorJson(),
 			"elasticstack_elasticsearch_ingest_processor_kv":                ingest.DataSourceProcessorKV(),
 			"elasticstack_elasticsearch_ingest_processor_lowercase":         ingest.DataSourceProcessorLowercase(),
 			"elasticstack_elasticsearch_ingest_processor_network_direction": ingest.DataSourceProcessorNetworkDirection(),
 			"elasticstack_elasticsearch_ingest_processor_pipeline":          ingest.DataSourceProcessorPipeline(),
+			"elasticstack_elasticsearch_ingest_processor_redact":            ingest.DataSourceProcessorRedact(),
 			"elasticstack_elasticsearch_ingest_processor_registered_domain": ingest.DataSourceProcessorRegisteredDomain(),
 			"elasticstack_elasticsearch_ingest_processor_remove":            ingest.DataSourceProcessorRemove(),
 			"elasticstack_elasticsearch_ingest_processor_rename":            ingest.DataSourceProcessorRename(),
+			"elasticstack_elasticsearch_ingest_processor_reroute":           ingest.DataSourceProcessorReroute(),
 			"elasticstack_elasticsearch_ingest_processor_script":            ingest.DataSourceProcessorScript(),
 			"elasticstack_elasticsearch_ingest_processor_set":               ingest.DataSourceProcessorSet(),
 			"elasticstack_elasticsearch_ingest_processor_set_security_user": ingest.DataSourceProcessorSetSecurityUser(),
 			"elasticstack_elasticsearch_ingest_processor_sort":              ingest.DataSourceProcessorSort(),
 			"elasticstack_elasticsearch_ingest_processor_split":             ingest.DataSourceProcessorSplit(),
+			"elasticstack_elasticsearch_ingest_processor_terminate":         ingest.DataSourceProcessorTerminate(),
 			"elasticstack_elasticsearch_ingest_processor_trim":              ingest.DataSourceProcessorTrim(),
 			"elasticstack_elasticsearch_ingest_processor_uppercase":         ingest.DataSourceProcessorUppercase(),
-			"elasticstack_elasticsearch_ingest_processor_urldecode":         ingest.DataSourceProcessorUrldecode(),
 			"elasticstack_elasticsearch_ingest_processor_uri_parts":         ingest.DataSourceProcessorUriParts(),
+			"elasticstack_elasticsearch_ingest_processor_urldecode":         ingest.DataSourceProcessorUrldecode(),
 			"elasticstack_elasticsearch_ingest_processor_user_agent":        ingest.DataSourceProcessorUserAgent(),
+			"elasticstack_elasticsearch_search_template_render":             cluster.DataSourceSearchTemplateRender(),
+			"elasticstack_elasticsearch_security_authenticate":              security.DataSourceAuthenticate(),
+			"elasticstack_elasticsearch_security_builtin_privileges":        security.DataSourceBuiltinPrivileges(),
+			"elasticstack_elasticsearch_security_has_privileges":            security.DataSourceHasPrivileges(),
 			"elasticstack_elasticsearch_security_role":                      security.DataSourceRole(),
 			"elasticstack_elasticsearch_security_role_mapping":              security.DataSourceRoleMapping(),
+			"elasticstack_elasticsearch_security_role_mapping_rule":         security.DataSourceRoleMappingRule(),
+			"elasticstack_elasticsearch_security_service_accounts":          security.DataSourceServiceAccounts(),
 			"elasticstack_elasticsearch_security_user":                      security.DataSourceUser(),
+			"elasticstack_elasticsearch_slm_status":                         cluster.DataSourceSlmStatus(),
 			"elasticstack_elasticsearch_snapshot_repository":                cluster.DataSourceSnapshotRespository(),
+			"elasticstack_elasticsearch_snapshots":                          cluster.DataSourceSnapshots(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"elasticstack_elasticsearch_cluster_settings":      cluster.ResourceSettings(),
-			"elasticstack_elasticsearch_component_template":    index.ResourceComponentTemplate(),
-			"elasticstack_elasticsearch_data_stream":           index.ResourceDataStream(),
-			"elasticstack_elasticsearch_index":                 index.ResourceIndex(),
-			"elasticstack_elasticsearch_index_lifecycle":       index.ResourceIlm(),
-			"elasticstack_elasticsearch_index_template":        index.ResourceTemplate(),
-			"elasticstack_elasticsearch_ingest_pipeline":       ingest.ResourceIngestPipeline(),
-			"elasticstack_elasticsearch_logstash_pipeline":     logstash.ResourceLogstashPipeline(),
-			"elasticstack_elasticsearch_security_api_key":      security.ResourceApiKey(),
-			"elasticstack_elasticsearch_security_role":         security.ResourceRole(),
-			"elasticstack_elasticsearch_security_role_mapping": security.ResourceRoleMapping(),
-			"elasticstack_elasticsearch_security_user":         security.ResourceUser(),
-			"elasticstack_elasticsearch_security_system_user":  security.ResourceSystemUser(),
-			"elasticstack_elasticsearch_snapshot_lifecycle":    cluster.ResourceSlm(),
-			"elasticstack_elasticsearch_snapshot_repository":   cluster.ResourceSnapshotRepository(),
-			"elasticstack_elasticsearch_script":                cluster.ResourceScript(),
+			"elasticstack_elasticsearch_alias":                          index.ResourceAlias(),
+			"elasticstack_elasticsearch_cluster_settings":               cluster.ResourceSettings(),
+			"elasticstack_elasticsearch_component_template":             index.ResourceComponentTemplate(),
+			"elasticstack_elasticsearch_data_stream":                    index.ResourceDataStream(),
+			"elasticstack_elasticsearch_index":                          index.ResourceIndex(),
+			"elasticstack_elasticsearch_index_lifecycle":                index.ResourceIlm(),
+			"elasticstack_elasticsearch_index_template":                 index.ResourceTemplate(),
+			"elasticstack_elasticsearch_ingest_pipeline":                ingest.ResourceIngestPipeline(),
+			"elasticstack_elasticsearch_logstash_pipeline":              logstash.ResourceLogstashPipeline(),
+			"elasticstack_elasticsearch_node_exclusion":                 cluster.ResourceNodeExclusion(),
+			"elasticstack_elasticsearch_security_api_key":               security.ResourceApiKey(),
+			"elasticstack_elasticsearch_security_cross_cluster_api_key": security.ResourceCrossClusterApiKey(),
+			"elasticstack_elasticsearch_security_privilege":             security.ResourcePrivilege(),
+			"elasticstack_elasticsearch_security_role":                  security.ResourceRole(),
+			"elasticstack_elasticsearch_security_role_mapping":          security.ResourceRoleMapping(),
+			"elasticstack_elasticsearch_security_service_account_token": security.ResourceServiceAccountToken(),
+			"elasticstack_elasticsearch_security_user":                  security.ResourceUser(),
+			"elasticstack_elasticsearch_security_system_user":           security.ResourceSystemUser(),
+			"elasticstack_elasticsearch_slm_status":                     cluster.ResourceSlmStatus(),
+			"elasticstack_elasticsearch_snapshot":                       cluster.ResourceSnapshot(),
+			"elasticstack_elasticsearch_snapshot_lifecycle":             cluster.ResourceSlm(),
+			"elasticstack_elasticsearch_snapshot_restore":               cluster.ResourceSnapshotRestore(),
+			"elasticstack_elasticsearch_snapshot_repository":            cluster.ResourceSnapshotRepository(),
+			"elasticstack_elasticsearch_script":                         cluster.ResourceScript(),
 		},
 	}
 